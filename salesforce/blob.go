@@ -0,0 +1,149 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// defaultBlobMaxBytes is the blob_max_bytes used when it isn't configured.
+const defaultBlobMaxBytes = 1 << 20
+
+// skipBlobFields returns true if blob_fields is "skip", i.e. base64 fields,
+// such as Attachment.Body, don't become columns.
+func skipBlobFields(config salesforceConfig) bool {
+	return config.BlobFields != nil && *config.BlobFields == BLOB_FIELDS_SKIP
+}
+
+// getBlobMaxBytes returns the configured blob_max_bytes, defaulting to 1 MiB.
+func getBlobMaxBytes(config salesforceConfig) int64 {
+	if config.BlobMaxBytes == nil || *config.BlobMaxBytes <= 0 {
+		return defaultBlobMaxBytes
+	}
+	return int64(*config.BlobMaxBytes)
+}
+
+// blobColumn returns the column of a base64 field, such as Attachment.Body or
+// Document.Body. Queries return the URL of such fields' content rather than the
+// content, so the column has a hydrate function reading the content of each
+// record, only when the column is requested.
+func blobColumn(objectName string, fieldName string, columnName string, label string) *plugin.Column {
+	return &plugin.Column{
+		Name:        columnName,
+		Type:        proto.ColumnType_STRING,
+		Description: fmt.Sprintf("%s. Content of the file, as text if it is valid UTF-8, otherwise base64-encoded. Content larger than blob_max_bytes is null.", label),
+		Hydrate:     getSalesforceBlobField(objectName, fieldName),
+		Transform:   transform.FromValue(),
+	}
+}
+
+// getSalesforceBlobField returns a hydrate function reading the content of
+// the base64 field fieldName of a record from its blob resource.
+// Ref: https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/dome_sobject_blob_retrieve.htm
+func getSalesforceBlobField(objectName string, fieldName string) func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+		record, ok := h.Item.(map[string]interface{})
+		if !ok {
+			return nil, nil
+		}
+		id, ok := record["Id"].(string)
+		if !ok || id == "" {
+			return nil, nil
+		}
+
+		client, err := connect(ctx, d)
+		if err != nil {
+			plugin.Logger(ctx).Error("salesforce.getSalesforceBlobField", "connection error", err)
+			return nil, err
+		}
+		if client == nil {
+			return nil, fmt.Errorf("salesforce.getSalesforceBlobField: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+		}
+
+		config := GetConfig(d.Connection)
+		path := fmt.Sprintf("services/data/v%s/sobjects/%s/%s/%s", getAPIVersion(config), objectName, id, fieldName)
+		data, err := blobRequestWithRetry(ctx, d, client, objectName, path, getBlobMaxBytes(config))
+		if err == errBlobTooLarge {
+			plugin.Logger(ctx).Warn("salesforce.getSalesforceBlobField", "msg", "skipping content larger than blob_max_bytes", "object", objectName, "id", id, "field", fieldName)
+			return nil, nil
+		}
+		if err != nil {
+			plugin.Logger(ctx).Error("salesforce.getSalesforceBlobField", "request error", err, "object", objectName, "id", id, "field", fieldName)
+			return nil, err
+		}
+		return blobValue(data), nil
+	}
+}
+
+// blobValue returns content as text if it is valid UTF-8, such as a text or
+// CSV file, and base64-encoded otherwise.
+func blobValue(content []byte) string {
+	if utf8.Valid(content) {
+		return string(content)
+	}
+	return base64.StdEncoding.EncodeToString(content)
+}
+
+var errBlobTooLarge = fmt.Errorf("blob content is larger than blob_max_bytes")
+
+// blobRequestWithRetry reads the content at path, relative to the instance
+// URL, reconnecting and retrying if the session has expired, like
+// bulkRequestWithRetry.
+func blobRequestWithRetry(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, objectName string, path string, maxBytes int64) ([]byte, error) {
+	config := GetConfig(d.Connection)
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	_, err = withSessionRetry(ctx, d, client, objectName, func(client *simpleforce.Client) (err error) {
+		data, err = blobRequest(ctx, httpClient, client, path, maxBytes)
+		return err
+	})
+	return data, err
+}
+
+// blobRequest reads the content at path, or returns errBlobTooLarge without
+// reading more than maxBytes of it if it is larger.
+func blobRequest(ctx context.Context, httpClient *http.Client, client *simpleforce.Client, path string, maxBytes int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/%s", strings.TrimSuffix(client.GetLoc(), "/"), path), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+client.GetSid())
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+		if err != nil {
+			return nil, err
+		}
+		return nil, simpleforce.ParseSalesforceError(resp.StatusCode, data)
+	}
+	if resp.ContentLength > maxBytes {
+		return nil, errBlobTooLarge
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, errBlobTooLarge
+	}
+	return data, nil
+}
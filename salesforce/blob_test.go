@@ -0,0 +1,143 @@
+package salesforce
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func TestBlobValue(t *testing.T) {
+	if got := blobValue([]byte("id,name\n1,Acme\n")); got != "id,name\n1,Acme\n" {
+		t.Errorf("text blob = %q, want the text as is", got)
+	}
+	if got := blobValue([]byte{0x89, 'P', 'N', 'G', 0xff}); got != "iVBOR/8=" {
+		t.Errorf("binary blob = %q, want it base64-encoded", got)
+	}
+}
+
+func TestBlobRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sid" {
+			t.Errorf("Authorization = %q", r.Header.Get("Authorization"))
+		}
+		switch r.URL.Path {
+		case "/services/data/v62.0/sobjects/Attachment/00Pxx0000001/Body":
+			w.Write([]byte("hello"))
+		case "/services/data/v62.0/sobjects/Attachment/00Pxx0000002/Body":
+			// Without a Content-Length, the size is only known once read
+			w.(http.Flusher).Flush()
+			w.Write(bytes.Repeat([]byte("x"), 100))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`[{"errorCode":"NOT_FOUND","message":"The requested resource does not exist"}]`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	client := simpleforce.NewClient(server.URL, "steampipe", simpleforce.DefaultAPIVersion)
+	client.SetSidLoc("sid", server.URL)
+	var buf bytes.Buffer
+	ctx := contextWithLogger(&buf)
+
+	data, err := blobRequest(ctx, http.DefaultClient, client, "services/data/v62.0/sobjects/Attachment/00Pxx0000001/Body", 5)
+	if err != nil || string(data) != "hello" {
+		t.Errorf("blobRequest() = %q, %v, want hello", data, err)
+	}
+	if _, err := blobRequest(ctx, http.DefaultClient, client, "services/data/v62.0/sobjects/Attachment/00Pxx0000001/Body", 4); err != errBlobTooLarge {
+		t.Errorf("error = %v, want errBlobTooLarge from the Content-Length", err)
+	}
+	if _, err := blobRequest(ctx, http.DefaultClient, client, "services/data/v62.0/sobjects/Attachment/00Pxx0000002/Body", 99); err != errBlobTooLarge {
+		t.Errorf("error = %v, want errBlobTooLarge from the content read", err)
+	}
+	if _, err := blobRequest(ctx, http.DefaultClient, client, "services/data/v62.0/sobjects/Attachment/00Pxx0000003/Body", 99); err == nil || !strings.Contains(err.Error(), "NOT_FOUND") {
+		t.Errorf("error = %v, want the Salesforce error", err)
+	}
+}
+
+func TestGetSalesforceBlobField(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/services/data/v62.0/sobjects/Attachment/00Pxx0000001/Body":
+			w.Write([]byte("small"))
+		case "/services/data/v62.0/sobjects/Attachment/00Pxx0000002/Body":
+			w.Write([]byte("larger than the limit"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	limit := 10
+	d := &plugin.QueryData{Connection: &plugin.Connection{Config: salesforceConfig{URL: stringPtr(server.URL), AccessToken: stringPtr("token"), BlobMaxBytes: &limit}}}
+	hydrate := getSalesforceBlobField("Attachment", "Body")
+	var buf bytes.Buffer
+	ctx := contextWithLogger(&buf)
+
+	value, err := hydrate(ctx, d, &plugin.HydrateData{Item: map[string]interface{}{"Id": "00Pxx0000001", "Body": "/services/data/v62.0/sobjects/Attachment/00Pxx0000001/Body"}})
+	if err != nil || value != "small" {
+		t.Errorf("hydrate() = %v, %v, want the content", value, err)
+	}
+	value, err = hydrate(ctx, d, &plugin.HydrateData{Item: map[string]interface{}{"Id": "00Pxx0000002"}})
+	if err != nil || value != nil {
+		t.Errorf("hydrate() = %v, %v, want nil for content over blob_max_bytes", value, err)
+	}
+	if !strings.Contains(buf.String(), "larger than blob_max_bytes") {
+		t.Errorf("log = %q, want a warning", buf.String())
+	}
+	value, err = hydrate(ctx, d, &plugin.HydrateData{Item: map[string]interface{}{"Name": "no id"}})
+	if err != nil || value != nil {
+		t.Errorf("hydrate() = %v, %v, want nil without an Id", value, err)
+	}
+}
+
+func TestDynamicColumns_BlobFields(t *testing.T) {
+	// Blob fields have the base64 type and the base64Binary soapType
+	client := newDescribeClient(t, `[
+		{"name":"Id","label":"Attachment ID","type":"id","soapType":"tns:ID"},
+		{"name":"Body","label":"Body","type":"base64","soapType":"xsd:base64Binary"},
+		{"name":"BodyLength","label":"Body Length","type":"int","soapType":"xsd:int"}
+	]`)
+
+	t.Run("content", func(t *testing.T) {
+		var buf bytes.Buffer
+		cols, keyColumns, salesforceCols, _ := dynamicColumns(contextWithLogger(&buf), nil, client, "Attachment", salesforceConfig{})
+		var body *plugin.Column
+		for _, c := range cols {
+			if c.Name == "body" {
+				body = c
+			}
+		}
+		if body == nil || body.Hydrate == nil || body.Type != proto.ColumnType_STRING {
+			t.Fatalf("body = %+v, want a string column with a hydrate function", body)
+		}
+		if _, ok := salesforceCols["body"]; ok {
+			t.Error("body should not be filterable")
+		}
+		for _, k := range keyColumns {
+			if k.Name == "body" {
+				t.Error("body should not be a key column")
+			}
+		}
+		if got := generateQuery(cols, "Attachment"); got != "SELECT Id, BodyLength FROM Attachment" {
+			t.Errorf("query = %q, want body left out", got)
+		}
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		skip := BLOB_FIELDS_SKIP
+		var buf bytes.Buffer
+		cols, _, _, _ := dynamicColumns(contextWithLogger(&buf), nil, client, "Attachment", salesforceConfig{BlobFields: &skip})
+		for _, c := range cols {
+			if c.Name == "body" {
+				t.Error("body should be skipped")
+			}
+		}
+		if got := generateQuery(cols, "Attachment"); got != "SELECT Id, BodyLength FROM Attachment" {
+			t.Errorf("query = %q, want body left out", got)
+		}
+	})
+}
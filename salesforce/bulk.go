@@ -0,0 +1,310 @@
+package salesforce
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// Bulk API 2.0 query jobs are only available from API version 47.0.
+const bulkMinAPIVersion = "47.0"
+
+// bulkResultsMaxRecords is the number of records requested per page of Bulk
+// API 2.0 query results. Each page is read into memory before its records are
+// streamed, and Salesforce returns pages of any size when it isn't set.
+const bulkResultsMaxRecords = 10000
+
+// bulkPollInterval is how often the state of a Bulk API 2.0 query job is checked.
+var bulkPollInterval = 2 * time.Second
+
+// bulkQueryJob is the job info returned by the Bulk API 2.0 query resources.
+// Ref: https://developer.salesforce.com/docs/atlas.en-us.api_asynch.meta/api_asynch/query_get_one_job.htm
+type bulkQueryJob struct {
+	ID           string `json:"id"`
+	State        string `json:"state"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// bulkQueryEnabled returns true if any query can be extracted with Bulk API 2.0.
+func bulkQueryEnabled(config salesforceConfig) bool {
+	return queryAPIForTotalSize(config, math.MaxInt) == QUERY_API_BULK
+}
+
+// bulkAPIVersion returns the API version used for Bulk API 2.0 requests: the
+// configured version, raised to bulkMinAPIVersion if it is older.
+func bulkAPIVersion(config salesforceConfig) string {
+	apiVersion := getAPIVersion(config)
+	version, err := strconv.ParseFloat(apiVersion, 64)
+	minVersion, _ := strconv.ParseFloat(bulkMinAPIVersion, 64)
+	if err != nil || version < minVersion {
+		return bulkMinAPIVersion
+	}
+	return apiVersion
+}
+
+// generateBulkQuery returns the query for a Bulk API 2.0 extract of tableName.
+// Bulk API 2.0 doesn't support compound address and location fields, and
+// returns relationship fields flattened, so they are left out, and ok is false
+// if any of them is requested.
+func generateBulkQuery(columns []*plugin.Column, requestedColumns []string, salesforceCols map[string]string, tableName string) (query string, ok bool) {
+	bulkColumns := []*plugin.Column{}
+	for _, column := range columns {
+		if _, ok := isRelationshipColumn(column); ok {
+			if slices.Contains(requestedColumns, column.Name) {
+				return "", false
+			}
+			continue
+		}
+		switch salesforceCols[column.Name] {
+		case "address", "location":
+			if slices.Contains(requestedColumns, column.Name) {
+				return "", false
+			}
+		default:
+			bulkColumns = append(bulkColumns, column)
+		}
+	}
+	return generateQuery(bulkColumns, tableName), true
+}
+
+// bulkQueryForTableScan returns the Bulk API 2.0 query for a scan of
+// tableName, or an empty string if the scan should use the REST query API.
+// Bulk is only used when the estimated number of matching records exceeds the
+// bulk threshold, and never for sorted or limited queries, since Bulk results
+// are only available once the whole job has completed.
+func bulkQueryForTableScan(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, tableName string, condition string, orderBy string, salesforceCols map[string]string) string {
+	config := GetConfig(d.Connection)
+	if !bulkQueryEnabled(config) || orderBy != "" || d.QueryContext.GetLimit() >= 0 {
+		return ""
+	}
+
+	query, ok := generateBulkQuery(d.Table.Columns, d.QueryContext.Columns, salesforceCols, tableName)
+	if !ok {
+		plugin.Logger(ctx).Debug("salesforce.bulkQueryForTableScan", "msg", "compound or relationship fields are requested, using REST", "table_name", d.Table.Name)
+		return ""
+	}
+
+	count, err := countRecords(ctx, d, client, tableName, condition)
+	if err != nil {
+		plugin.Logger(ctx).Warn("salesforce.bulkQueryForTableScan", "msg", "unable to estimate the number of records, using REST", "table_name", d.Table.Name, "error", err)
+		return ""
+	}
+	if queryAPIForTotalSize(config, count) != QUERY_API_BULK {
+		return ""
+	}
+	if condition != "" {
+		query = fmt.Sprintf("%s where %s", query, condition)
+	}
+	return query
+}
+
+// runBulkQuery extracts the results of query with a Bulk API 2.0 query job and
+// passes each record to stream, converted to the values the REST query API
+// returns. Like streamQueryRecords, it stops early when stream returns false.
+// streamed reports whether any record was streamed before an error, in which
+// case the query can't be retried with REST without duplicating rows.
+func runBulkQuery(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, objectName string, query string, salesforceCols map[string]string, stream func(record map[string]interface{}) bool) (_ *simpleforce.Client, streamed bool, err error) {
+	jobsPath := fmt.Sprintf("services/data/v%s/jobs/query", bulkAPIVersion(GetConfig(d.Connection)))
+
+	// Query jobs take the same operations as the REST resources
+	body, err := json.Marshal(map[string]string{"operation": getQueryResource(d), "query": query})
+	if err != nil {
+		return client, false, err
+	}
+	client, data, _, err := bulkRequestWithRetry(ctx, d, client, objectName, http.MethodPost, jobsPath, body)
+	if err != nil {
+		return client, false, err
+	}
+	var job bulkQueryJob
+	if err := json.Unmarshal(data, &job); err != nil {
+		return client, false, fmt.Errorf("failed to parse bulk query job: %v", err)
+	}
+	jobPath := fmt.Sprintf("%s/%s", jobsPath, job.ID)
+	plugin.Logger(ctx).Debug("salesforce.runBulkQuery", "msg", "bulk query job created", "object_name", objectName, "job_id", job.ID)
+
+	// Delete the job and its results once they have been read. This is best
+	// effort, as Salesforce deletes jobs after 7 days anyway.
+	defer func() {
+		if _, _, _, deleteErr := bulkRequestWithRetry(ctx, d, client, objectName, http.MethodDelete, jobPath, nil); deleteErr != nil {
+			plugin.Logger(ctx).Debug("salesforce.runBulkQuery", "msg", "unable to delete bulk query job", "job_id", job.ID, "error", deleteErr)
+		}
+	}()
+
+	for job.State != "JobComplete" {
+		switch job.State {
+		case "Failed", "Aborted":
+			return client, false, fmt.Errorf("bulk query job %s %s: %s", job.ID, strings.ToLower(job.State), job.ErrorMessage)
+		}
+		select {
+		case <-ctx.Done():
+			return client, false, ctx.Err()
+		case <-time.After(bulkPollInterval):
+		}
+
+		client, data, _, err = bulkRequestWithRetry(ctx, d, client, objectName, http.MethodGet, jobPath, nil)
+		if err != nil {
+			return client, false, err
+		}
+		if err := json.Unmarshal(data, &job); err != nil {
+			return client, false, fmt.Errorf("failed to parse bulk query job: %v", err)
+		}
+	}
+
+	fieldTypes := map[string]string{}
+	for columnName, fieldType := range salesforceCols {
+		fieldTypes[getSalesforceColumnName(columnName)] = fieldType
+	}
+
+	// Results are split into pages, each pointing to the next one with a locator
+	locator := ""
+	for {
+		params := url.Values{"maxRecords": {strconv.Itoa(bulkResultsMaxRecords)}}
+		if locator != "" {
+			params.Set("locator", locator)
+		}
+		resultsPath := fmt.Sprintf("%s/results?%s", jobPath, params.Encode())
+		var header http.Header
+		client, data, header, err = bulkRequestWithRetry(ctx, d, client, objectName, http.MethodGet, resultsPath, nil)
+		if err != nil {
+			return client, streamed, err
+		}
+
+		records, err := parseBulkQueryResults(data, fieldTypes)
+		if err != nil {
+			return client, streamed, err
+		}
+		for _, record := range records {
+			streamed = true
+			if !stream(record) {
+				return client, streamed, nil
+			}
+		}
+
+		locator = header.Get("Sforce-Locator")
+		if locator == "" || locator == "null" {
+			return client, streamed, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return client, streamed, err
+		}
+	}
+}
+
+// parseBulkQueryResults converts a page of Bulk API 2.0 CSV results into
+// records keyed by field name. Values are converted by the field's soapType in
+// fieldTypes to what the REST query API returns: numbers and booleans are
+// parsed, and empty values are null.
+func parseBulkQueryResults(data []byte, fieldTypes map[string]string) ([]map[string]interface{}, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return []map[string]interface{}{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bulk query results: %v", err)
+	}
+
+	records := []map[string]interface{}{}
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse bulk query results: %v", err)
+		}
+
+		record := map[string]interface{}{}
+		for i, field := range header {
+			record[field] = bulkFieldValue(row[i], fieldTypes[field])
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// bulkFieldValue converts a Bulk API 2.0 CSV value of a field with the given
+// soapType. Values that can't be parsed are kept as strings.
+func bulkFieldValue(value string, fieldType string) interface{} {
+	if value == "" {
+		return nil
+	}
+	switch fieldType {
+	case "boolean":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	case "double", "int":
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	}
+	return value
+}
+
+// bulkRequestWithRetry sends a Bulk API 2.0 request to path, relative to the
+// instance URL, and returns the response body and headers. Like
+// queryWithRetry, it reconnects and retries if the session has expired.
+func bulkRequestWithRetry(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, objectName string, method string, path string, body []byte) (*simpleforce.Client, []byte, http.Header, error) {
+	config := GetConfig(d.Connection)
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return client, nil, nil, err
+	}
+
+	var data []byte
+	var header http.Header
+	client, err = withSessionRetry(ctx, d, client, objectName, func(client *simpleforce.Client) (err error) {
+		data, header, err = bulkRequest(ctx, httpClient, client, method, path, body)
+		return err
+	})
+	if err != nil {
+		return client, nil, nil, queryTimeoutError(err, config)
+	}
+	return client, data, header, nil
+}
+
+// bulkRequest sends a single Bulk API 2.0 request. Unlike client.ApexREST(), it
+// returns the response headers, which carry the locator of the next page of
+// query results.
+func bulkRequest(ctx context.Context, httpClient *http.Client, client *simpleforce.Client, method string, path string, body []byte) ([]byte, http.Header, error) {
+	var requestBody io.Reader
+	if body != nil {
+		requestBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", strings.TrimSuffix(client.GetLoc(), "/"), path), requestBody)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+client.GetSid())
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, nil, simpleforce.ParseSalesforceError(resp.StatusCode, data)
+	}
+	return data, resp.Header, nil
+}
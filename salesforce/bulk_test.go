@@ -0,0 +1,308 @@
+package salesforce
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// fakeBulkAPI is a minimal Bulk API 2.0 query endpoint. The job completes on
+// its second status check, with or without failing, and its results are split
+// into the given CSV pages.
+type fakeBulkAPI struct {
+	server       *httptest.Server
+	finalState   string
+	pages        []string
+	query        string
+	operation    string
+	statusChecks int
+	pagesServed  int
+	maxRecords   []string
+	deleted      bool
+}
+
+func newFakeBulkAPI(t *testing.T, finalState string, pages ...string) *fakeBulkAPI {
+	t.Helper()
+	f := &fakeBulkAPI{finalState: finalState, pages: pages}
+	jobsPath := "/services/data/v" + bulkAPIVersion(salesforceConfig{}) + "/jobs/query"
+	jobPath := jobsPath + "/750xx"
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == jobsPath:
+			var job map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&job); err != nil || (job["operation"] != "query" && job["operation"] != "queryAll") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f.query, f.operation = job["query"], job["operation"]
+			w.Write([]byte(`{"id":"750xx","state":"UploadComplete"}`))
+		case r.Method == http.MethodGet && r.URL.Path == jobPath:
+			f.statusChecks++
+			state := "InProgress"
+			if f.statusChecks > 1 {
+				state = f.finalState
+			}
+			fmt.Fprintf(w, `{"id":"750xx","state":%q,"errorMessage":"INVALID_FIELD"}`, state)
+		case r.Method == http.MethodGet && r.URL.Path == jobPath+"/results":
+			page := 0
+			if locator := r.URL.Query().Get("locator"); locator != "" {
+				fmt.Sscanf(locator, "page%d", &page)
+			}
+			f.pagesServed++
+			f.maxRecords = append(f.maxRecords, r.URL.Query().Get("maxRecords"))
+			if page < len(f.pages)-1 {
+				w.Header().Set("Sforce-Locator", fmt.Sprintf("page%d", page+1))
+			} else {
+				w.Header().Set("Sforce-Locator", "null")
+			}
+			w.Header().Set("Content-Type", "text/csv")
+			w.Write([]byte(f.pages[page]))
+		case r.Method == http.MethodDelete && r.URL.Path == jobPath:
+			f.deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeBulkAPI) connect(t *testing.T) (*plugin.QueryData, *simpleforce.Client) {
+	t.Helper()
+	d := &plugin.QueryData{Connection: &plugin.Connection{Config: salesforceConfig{URL: stringPtr(f.server.URL), AccessToken: stringPtr("token")}}}
+	var buf bytes.Buffer
+	client, err := connect(contextWithLogger(&buf), d)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	return d, client
+}
+
+func TestRunBulkQuery(t *testing.T) {
+	previousInterval := bulkPollInterval
+	bulkPollInterval = 0
+	t.Cleanup(func() { bulkPollInterval = previousInterval })
+
+	salesforceCols := map[string]string{"id": "ID", "name": "string", "annual_revenue": "double", "is_deleted": "boolean"}
+	pages := []string{
+		"\"Id\",\"Name\",\"AnnualRevenue\",\"IsDeleted\"\n\"001a\",\"Acme, Inc.\",\"1500000.5\",\"false\"\n\"001b\",\"Globex\",\"\",\"true\"\n",
+		"\"Id\",\"Name\",\"AnnualRevenue\",\"IsDeleted\"\n\"001c\",\"Multi\nline\",\"42\",\"false\"\n",
+	}
+	query := "SELECT Id, Name, AnnualRevenue, IsDeleted FROM Account"
+
+	t.Run("streams every page", func(t *testing.T) {
+		f := newFakeBulkAPI(t, "JobComplete", pages...)
+		d, client := f.connect(t)
+
+		var buf bytes.Buffer
+		records := []map[string]interface{}{}
+		_, streamed, err := runBulkQuery(contextWithLogger(&buf), d, client, "Account", query, salesforceCols, func(record map[string]interface{}) bool {
+			records = append(records, record)
+			return true
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !streamed || f.query != query || f.operation != "query" {
+			t.Errorf("streamed = %v, query = %q, operation = %q, want %q streamed by a query job", streamed, f.query, f.operation, query)
+		}
+		if len(records) != 3 {
+			t.Fatalf("len(records) = %d, want 3", len(records))
+		}
+		expected := map[string]interface{}{"Id": "001a", "Name": "Acme, Inc.", "AnnualRevenue": 1500000.5, "IsDeleted": false}
+		for key, value := range expected {
+			if records[0][key] != value {
+				t.Errorf("records[0][%s] = %#v, want %#v", key, records[0][key], value)
+			}
+		}
+		if records[1]["AnnualRevenue"] != nil || records[1]["IsDeleted"] != true {
+			t.Errorf("records[1] = %v, want null AnnualRevenue and IsDeleted true", records[1])
+		}
+		if records[2]["Name"] != "Multi\nline" {
+			t.Errorf("records[2][Name] = %q, want multi-line value", records[2]["Name"])
+		}
+		if f.statusChecks != 2 || f.pagesServed != 2 || !f.deleted {
+			t.Errorf("status checks = %d, pages = %d, deleted = %v, want 2, 2, true", f.statusChecks, f.pagesServed, f.deleted)
+		}
+		// Every page is bounded, so a large extract isn't read into memory at once
+		if expected := strconv.Itoa(bulkResultsMaxRecords); len(f.maxRecords) != 2 || f.maxRecords[0] != expected || f.maxRecords[1] != expected {
+			t.Errorf("maxRecords = %v, want %s for every page", f.maxRecords, expected)
+		}
+	})
+
+	t.Run("include deleted", func(t *testing.T) {
+		f := newFakeBulkAPI(t, "JobComplete", pages...)
+		d, client := f.connect(t)
+		includeDeleted := true
+		d.Connection.Config = salesforceConfig{URL: stringPtr(f.server.URL), AccessToken: stringPtr("token"), IncludeDeleted: &includeDeleted}
+
+		var buf bytes.Buffer
+		_, _, err := runBulkQuery(contextWithLogger(&buf), d, client, "Account", query, salesforceCols, func(record map[string]interface{}) bool {
+			return true
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if f.operation != "queryAll" {
+			t.Errorf("operation = %q, want queryAll", f.operation)
+		}
+	})
+
+	t.Run("stops when no more rows are needed", func(t *testing.T) {
+		f := newFakeBulkAPI(t, "JobComplete", pages...)
+		d, client := f.connect(t)
+
+		var buf bytes.Buffer
+		_, streamed, err := runBulkQuery(contextWithLogger(&buf), d, client, "Account", query, salesforceCols, func(record map[string]interface{}) bool {
+			return false
+		})
+		if err != nil || !streamed {
+			t.Fatalf("streamed = %v, err = %v, want one record streamed", streamed, err)
+		}
+		if f.pagesServed != 1 || !f.deleted {
+			t.Errorf("pages = %d, deleted = %v, want 1, true", f.pagesServed, f.deleted)
+		}
+	})
+
+	t.Run("stops at the end of a page without requesting the next", func(t *testing.T) {
+		f := newFakeBulkAPI(t, "JobComplete", pages...)
+		d, client := f.connect(t)
+
+		var buf bytes.Buffer
+		ids := []string{}
+		_, _, err := runBulkQuery(contextWithLogger(&buf), d, client, "Account", query, salesforceCols, func(record map[string]interface{}) bool {
+			ids = append(ids, record["Id"].(string))
+			return len(ids) < 2
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expected := []string{"001a", "001b"}; !slices.Equal(ids, expected) {
+			t.Errorf("ids = %v, want %v", ids, expected)
+		}
+		if f.pagesServed != 1 || !f.deleted {
+			t.Errorf("pages = %d, deleted = %v, want 1, true", f.pagesServed, f.deleted)
+		}
+	})
+
+	t.Run("failed job", func(t *testing.T) {
+		f := newFakeBulkAPI(t, "Failed", pages...)
+		d, client := f.connect(t)
+
+		var buf bytes.Buffer
+		_, streamed, err := runBulkQuery(contextWithLogger(&buf), d, client, "Account", query, salesforceCols, func(record map[string]interface{}) bool {
+			return true
+		})
+		if err == nil || !strings.Contains(err.Error(), "failed: INVALID_FIELD") {
+			t.Errorf("err = %v, want failed job error", err)
+		}
+		if streamed || f.pagesServed != 0 {
+			t.Errorf("streamed = %v, pages = %d, want nothing streamed so REST can be used", streamed, f.pagesServed)
+		}
+	})
+
+	t.Run("bulk not permitted", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`[{"errorCode":"API_DISABLED_FOR_ORG","message":"Bulk API is not enabled for this Organization"}]`))
+		}))
+		t.Cleanup(server.Close)
+		d := &plugin.QueryData{Connection: &plugin.Connection{Config: salesforceConfig{URL: stringPtr(server.URL), AccessToken: stringPtr("token")}}}
+		var buf bytes.Buffer
+		client, err := connect(contextWithLogger(&buf), d)
+		if err != nil {
+			t.Fatalf("connect: %v", err)
+		}
+
+		_, streamed, err := runBulkQuery(contextWithLogger(&buf), d, client, "Account", query, salesforceCols, func(record map[string]interface{}) bool {
+			return true
+		})
+		if err == nil || streamed {
+			t.Errorf("streamed = %v, err = %v, want an error before streaming", streamed, err)
+		}
+	})
+}
+
+func TestParseBulkQueryResults(t *testing.T) {
+	fieldTypes := map[string]string{"Amount": "double", "NumberOfEmployees": "int", "IsWon": "boolean", "CloseDate": "date"}
+
+	records, err := parseBulkQueryResults([]byte("\"Amount\",\"NumberOfEmployees\",\"IsWon\",\"CloseDate\",\"Unknown\"\n\"12.5\",\"300\",\"true\",\"2024-01-31\",\"x\"\n\"\",\"\",\"\",\"\",\"\"\n"), fieldTypes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("len(records) = %d, want 2", len(records))
+	}
+	expected := map[string]interface{}{"Amount": 12.5, "NumberOfEmployees": float64(300), "IsWon": true, "CloseDate": "2024-01-31", "Unknown": "x"}
+	for key, value := range expected {
+		if records[0][key] != value {
+			t.Errorf("records[0][%s] = %#v, want %#v", key, records[0][key], value)
+		}
+		if records[1][key] != nil {
+			t.Errorf("records[1][%s] = %#v, want nil", key, records[1][key])
+		}
+	}
+
+	t.Run("empty results", func(t *testing.T) {
+		records, err := parseBulkQueryResults([]byte(""), fieldTypes)
+		if err != nil || len(records) != 0 {
+			t.Errorf("records = %v, err = %v, want none", records, err)
+		}
+	})
+
+	t.Run("malformed csv", func(t *testing.T) {
+		if _, err := parseBulkQueryResults([]byte("\"Amount\"\n\"12.5\",\"extra\"\n"), fieldTypes); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestBulkAPIVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiVersion *string
+		expected   string
+	}{
+		{"default is kept", nil, defaultAPIVersion},
+		{"older version is raised", stringPtr("46.0"), bulkMinAPIVersion},
+		{"newer version is kept", stringPtr("58.0"), "58.0"},
+		{"invalid version", stringPtr("latest"), bulkMinAPIVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bulkAPIVersion(salesforceConfig{APIVersion: tt.apiVersion}); got != tt.expected {
+				t.Errorf("bulkAPIVersion() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGenerateBulkQuery(t *testing.T) {
+	columns := []*plugin.Column{
+		{Name: "organization_id", Type: proto.ColumnType_STRING},
+		{Name: "id", Type: proto.ColumnType_STRING},
+		{Name: "billing_address", Type: proto.ColumnType_JSON},
+		{Name: "billing_city", Type: proto.ColumnType_STRING},
+	}
+	salesforceCols := map[string]string{"id": "ID", "billing_address": "address", "billing_city": "string"}
+
+	query, ok := generateBulkQuery(columns, []string{"id", "billing_city"}, salesforceCols, "Account")
+	if !ok || query != "SELECT Id, BillingCity FROM Account" {
+		t.Errorf("generateBulkQuery() = %q, %v, want compound field left out", query, ok)
+	}
+
+	if _, ok := generateBulkQuery(columns, []string{"id", "billing_address"}, salesforceCols, "Account"); ok {
+		t.Error("generateBulkQuery() should not be usable when a compound field is requested")
+	}
+}
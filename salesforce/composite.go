@@ -0,0 +1,203 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// compositeBatchSize is the maximum number of subrequests of a composite batch
+// request.
+const compositeBatchSize = 25
+
+// compositeSubrequest is a subrequest of a composite batch request. Its URL is
+// relative to /services/data, e.g. "v62.0/sobjects/Account/001xx000003DGb0AAG".
+// Ref: https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_composite_batch.htm
+type compositeSubrequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+// compositeResult is the response to a subrequest of a composite batch request.
+type compositeResult struct {
+	StatusCode int             `json:"statusCode"`
+	Result     json.RawMessage `json:"result"`
+}
+
+// compositeBatchGet issues a GET for each of urls, relative to /services/data,
+// in composite batch requests of up to compositeBatchSize subrequests, and
+// returns the responses in the order of urls. A subrequest that fails, e.g.
+// because its record doesn't exist, doesn't fail the others; check the status
+// code of each result.
+func compositeBatchGet(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, urls []string) (*simpleforce.Client, []compositeResult, error) {
+	path := fmt.Sprintf("services/data/v%s/composite/batch", getAPIVersion(GetConfig(d.Connection)))
+	results := make([]compositeResult, 0, len(urls))
+	for start := 0; start < len(urls); start += compositeBatchSize {
+		chunk := urls[start:min(start+compositeBatchSize, len(urls))]
+		request := struct {
+			BatchRequests []compositeSubrequest `json:"batchRequests"`
+		}{}
+		for _, u := range chunk {
+			request.BatchRequests = append(request.BatchRequests, compositeSubrequest{Method: http.MethodGet, URL: u})
+		}
+		body, err := json.Marshal(request)
+		if err != nil {
+			return client, nil, err
+		}
+
+		var data []byte
+		client, data, err = restRequestWithRetry(ctx, d, client, http.MethodPost, path, body)
+		if err != nil {
+			return client, nil, err
+		}
+		response := struct {
+			Results []compositeResult `json:"results"`
+		}{}
+		if err := json.Unmarshal(data, &response); err != nil {
+			return client, nil, fmt.Errorf("failed to parse composite batch response: %v", err)
+		}
+		if len(response.Results) != len(chunk) {
+			return client, nil, fmt.Errorf("composite batch returned %d results for %d subrequests", len(response.Results), len(chunk))
+		}
+		results = append(results, response.Results...)
+	}
+	return client, results, nil
+}
+
+// compositeRecord returns the record of a subrequest's result, or nil if the
+// record wasn't found.
+func compositeRecord(result compositeResult) (map[string]interface{}, error) {
+	if result.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if result.StatusCode < 200 || result.StatusCode > 299 {
+		errs := []struct {
+			ErrorCode string `json:"errorCode"`
+			Message   string `json:"message"`
+		}{}
+		messages := []string{}
+		if json.Unmarshal(result.Result, &errs) == nil {
+			for _, e := range errs {
+				messages = append(messages, fmt.Sprintf("%s: %s", e.ErrorCode, e.Message))
+			}
+		}
+		return nil, fmt.Errorf("subrequest failed with status %d: %s", result.StatusCode, strings.Join(messages, "; "))
+	}
+	record := map[string]interface{}{}
+	if err := json.Unmarshal(result.Result, &record); err != nil {
+		return nil, fmt.Errorf("failed to parse composite batch record: %v", err)
+	}
+	return record, nil
+}
+
+// recordBatcher combines the records requested concurrently by a query, such
+// as by the Get hydrates of an id IN (...) filter, into composite batch
+// requests. A request is sent at once if no other is in flight, so a single
+// Get isn't delayed; the requests made meanwhile are sent together next.
+type recordBatcher struct {
+	pending []*recordCall
+	running bool
+}
+
+type recordCall struct {
+	objectName string
+	id         string
+	record     map[string]interface{}
+	err        error
+	done       chan struct{}
+}
+
+// recordBatchers holds the recordBatcher of each query with records in
+// flight, keyed by its QueryContext, which the copies of the query's
+// QueryData made for each Get share. A batcher is removed once it has no
+// pending requests.
+var (
+	recordBatchersMu sync.Mutex
+	recordBatchers   = map[*plugin.QueryContext]*recordBatcher{}
+)
+
+// getRecordBatched returns the record of objectName with id, or nil if it
+// doesn't exist.
+func getRecordBatched(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, objectName string, id string) (map[string]interface{}, error) {
+	call := &recordCall{objectName: objectName, id: id, done: make(chan struct{})}
+	recordBatchersMu.Lock()
+	batcher, ok := recordBatchers[d.QueryContext]
+	if !ok {
+		batcher = &recordBatcher{}
+		recordBatchers[d.QueryContext] = batcher
+	}
+	batcher.pending = append(batcher.pending, call)
+	if !batcher.running {
+		batcher.running = true
+		// The batches are sent with the context of the Get that started them;
+		// the Gets of a query are cancelled together
+		go batcher.run(ctx, d, client)
+	}
+	recordBatchersMu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.record, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// run sends the pending requests, up to compositeBatchSize at a time, until
+// none are left.
+func (b *recordBatcher) run(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client) {
+	for {
+		recordBatchersMu.Lock()
+		if len(b.pending) == 0 {
+			b.running = false
+			delete(recordBatchers, d.QueryContext)
+			recordBatchersMu.Unlock()
+			return
+		}
+		calls := b.pending[:min(len(b.pending), compositeBatchSize)]
+		b.pending = b.pending[len(calls):]
+		recordBatchersMu.Unlock()
+
+		client = b.fetch(ctx, d, client, calls)
+		for _, call := range calls {
+			close(call.done)
+		}
+	}
+}
+
+// fetch sets the record or error of each call. A single call is a plain Get,
+// which costs the same as a batch of one.
+func (b *recordBatcher) fetch(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, calls []*recordCall) *simpleforce.Client {
+	if len(calls) == 1 {
+		call := calls[0]
+		var obj *simpleforce.SObject
+		client, obj, call.err = getWithRetry(ctx, d, client, call.objectName, call.id)
+		if call.err == nil && obj != nil {
+			call.err = decodeQueryResult(ctx, obj, &call.record)
+		}
+		return client
+	}
+
+	apiVersion := getAPIVersion(GetConfig(d.Connection))
+	urls := make([]string, len(calls))
+	for i, call := range calls {
+		urls[i] = fmt.Sprintf("v%s/sobjects/%s/%s", apiVersion, url.PathEscape(call.objectName), url.PathEscape(call.id))
+	}
+	plugin.Logger(ctx).Debug("salesforce.recordBatcher.fetch", "msg", "fetching records with a composite batch request", "records", len(calls))
+	client, results, err := compositeBatchGet(ctx, d, client, urls)
+	for i, call := range calls {
+		if err != nil {
+			call.err = err
+			continue
+		}
+		call.record, call.err = compositeRecord(results[i])
+	}
+	return client
+}
@@ -0,0 +1,250 @@
+package salesforce
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// newCompositeClient returns a client whose requests are served by handler,
+// along with query data of a query on a connection named name.
+func newCompositeClient(name string, handler func(r *http.Request) (int, string)) (*simpleforce.Client, *plugin.QueryData) {
+	instanceURL := "https://acme.my.salesforce.com"
+	client := simpleforce.NewClient(instanceURL, "steampipe", simpleforce.DefaultAPIVersion)
+	client.SetSidLoc("sid", instanceURL)
+	client.SetHttpClient(&http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		status, body := handler(r)
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	})})
+	d := &plugin.QueryData{
+		Connection:   &plugin.Connection{Name: name, Config: salesforceConfig{URL: stringPtr(instanceURL), AccessToken: stringPtr("token")}},
+		QueryContext: &plugin.QueryContext{},
+	}
+	return client, d
+}
+
+// compositeResponse answers a composite batch request with the Id of each
+// subrequest's URL, or a 404 for ids starting with "missing".
+func compositeResponse(t *testing.T, r *http.Request) (urls []string, body string) {
+	t.Helper()
+	request := struct {
+		BatchRequests []compositeSubrequest `json:"batchRequests"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		t.Fatalf("decoding composite batch request: %v", err)
+	}
+	results := []string{}
+	for _, subrequest := range request.BatchRequests {
+		if subrequest.Method != http.MethodGet {
+			t.Errorf("subrequest method = %s, want GET", subrequest.Method)
+		}
+		urls = append(urls, subrequest.URL)
+		id := subrequest.URL[strings.LastIndex(subrequest.URL, "/")+1:]
+		if strings.HasPrefix(id, "missing") {
+			results = append(results, `{"statusCode":404,"result":[{"errorCode":"NOT_FOUND","message":"The requested resource does not exist"}]}`)
+			continue
+		}
+		results = append(results, fmt.Sprintf(`{"statusCode":200,"result":{"Id":%q}}`, id))
+	}
+	return urls, fmt.Sprintf(`{"hasErrors":false,"results":[%s]}`, strings.Join(results, ","))
+}
+
+func TestCompositeBatchGet(t *testing.T) {
+	var buf bytes.Buffer
+	batches := [][]string{}
+	client, d := newCompositeClient("composite_batch_get", func(r *http.Request) (int, string) {
+		if r.Method != http.MethodPost || r.URL.Path != "/services/data/v"+defaultAPIVersion+"/composite/batch" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		urls, body := compositeResponse(t, r)
+		batches = append(batches, urls)
+		return http.StatusOK, body
+	})
+
+	urls := []string{}
+	for i := range 60 {
+		urls = append(urls, fmt.Sprintf("v%s/sobjects/Account/%03d", defaultAPIVersion, i))
+	}
+	urls[7] = fmt.Sprintf("v%s/sobjects/Account/missing", defaultAPIVersion)
+
+	_, results, err := compositeBatchGet(contextWithLogger(&buf), d, client, urls)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(batches) != 3 || len(batches[0]) != 25 || len(batches[1]) != 25 || len(batches[2]) != 10 {
+		t.Fatalf("batch sizes = %d requests, want 25, 25 and 10", len(batches))
+	}
+	if len(results) != 60 {
+		t.Fatalf("results = %d, want 60", len(results))
+	}
+	for i, result := range results {
+		record, err := compositeRecord(result)
+		if i == 7 {
+			if err != nil || record != nil {
+				t.Errorf("missing record = %v, %v, want nil, nil", record, err)
+			}
+			continue
+		}
+		if err != nil || record["Id"] != fmt.Sprintf("%03d", i) {
+			t.Errorf("result %d = %v, %v, want Id %03d", i, record, err, i)
+		}
+	}
+}
+
+func TestCompositeRecord_Error(t *testing.T) {
+	_, err := compositeRecord(compositeResult{
+		StatusCode: http.StatusBadRequest,
+		Result:     json.RawMessage(`[{"errorCode":"MALFORMED_ID","message":"Account ID: id value of incorrect type: 42"}]`),
+	})
+	if err == nil || !strings.Contains(err.Error(), "MALFORMED_ID") {
+		t.Errorf("error = %v, want the subrequest's MALFORMED_ID error", err)
+	}
+}
+
+func TestGetRecordBatched(t *testing.T) {
+	var buf bytes.Buffer
+	var lock sync.Mutex
+	gets, posts := 0, []int{}
+	started, release := make(chan struct{}), make(chan struct{})
+	client, d := newCompositeClient("get_record_batched", func(r *http.Request) (int, string) {
+		if r.Method == http.MethodGet {
+			// Hold the first Get until the other records are pending
+			close(started)
+			<-release
+			lock.Lock()
+			gets++
+			lock.Unlock()
+			return http.StatusOK, `{"Id":"first"}`
+		}
+		urls, body := compositeResponse(t, r)
+		lock.Lock()
+		posts = append(posts, len(urls))
+		lock.Unlock()
+		return http.StatusOK, body
+	})
+	ctx := contextWithLogger(&buf)
+
+	type result struct {
+		id     string
+		record map[string]interface{}
+		err    error
+	}
+	results := make(chan result, 31)
+	get := func(id string) {
+		record, err := getRecordBatched(ctx, d, client, "Account", id)
+		results <- result{id, record, err}
+	}
+	go get("first")
+	<-started
+	recordBatchersMu.Lock()
+	batcher := recordBatchers[d.QueryContext]
+	recordBatchersMu.Unlock()
+	for i := range 30 {
+		id := fmt.Sprintf("%02d", i)
+		if i == 3 {
+			id = "missing"
+		}
+		go get(id)
+	}
+	for {
+		recordBatchersMu.Lock()
+		pending := len(batcher.pending)
+		recordBatchersMu.Unlock()
+		if pending == 30 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+
+	for range 31 {
+		r := <-results
+		switch {
+		case r.err != nil:
+			t.Errorf("%s: unexpected error: %v", r.id, r.err)
+		case r.id == "missing":
+			if r.record != nil {
+				t.Errorf("missing: record = %v, want nil", r.record)
+			}
+		case r.record["Id"] != r.id:
+			t.Errorf("%s: record = %v", r.id, r.record)
+		}
+	}
+	if gets != 1 || len(posts) != 2 || posts[0] != 25 || posts[1] != 5 {
+		t.Errorf("gets = %d, composite batches = %v, want 1 Get and batches of 25 and 5", gets, posts)
+	}
+	recordBatchersMu.Lock()
+	_, ok := recordBatchers[d.QueryContext]
+	recordBatchersMu.Unlock()
+	if ok {
+		t.Error("the batcher of the query should be removed once it has no pending requests")
+	}
+}
+
+func TestGetRecordBatched_SeparateQueries(t *testing.T) {
+	var buf bytes.Buffer
+	release := make(chan struct{})
+	client, d := newCompositeClient("get_record_batched_queries", func(r *http.Request) (int, string) {
+		id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		if id == "held" {
+			<-release
+		}
+		return http.StatusOK, fmt.Sprintf(`{"Id":%q}`, id)
+	})
+	ctx := contextWithLogger(&buf)
+
+	held := make(chan error, 1)
+	go func() {
+		_, err := getRecordBatched(ctx, d, client, "Account", "held")
+		held <- err
+	}()
+
+	// Another query on the same connection isn't batched with, nor waits
+	// for, the Gets of the first
+	other := &plugin.QueryData{Connection: d.Connection, QueryContext: &plugin.QueryContext{}}
+	record, err := getRecordBatched(ctx, other, client, "Account", "other")
+	if err != nil || record["Id"] != "other" {
+		t.Errorf("record = %v, %v, want Id other", record, err)
+	}
+	close(release)
+	if err := <-held; err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRecordBatcherFetch_EscapesURLs(t *testing.T) {
+	var buf bytes.Buffer
+	urls := []string{}
+	client, d := newCompositeClient("record_batcher_escape", func(r *http.Request) (int, string) {
+		var body string
+		urls, body = compositeResponse(t, r)
+		return http.StatusOK, body
+	})
+	calls := []*recordCall{
+		{objectName: "Account", id: "001xx000003DGb0AAG"},
+		{objectName: "Account", id: "../Contact/003xx?fields=Name"},
+	}
+	(&recordBatcher{}).fetch(contextWithLogger(&buf), d, client, calls)
+
+	expected := []string{
+		"v" + defaultAPIVersion + "/sobjects/Account/001xx000003DGb0AAG",
+		"v" + defaultAPIVersion + "/sobjects/Account/..%2FContact%2F003xx%3Ffields=Name",
+	}
+	if !slices.Equal(urls, expected) {
+		t.Errorf("subrequest URLs = %q, want %q", urls, expected)
+	}
+}
@@ -1,6 +1,8 @@
 package salesforce
 
 import (
+	"strings"
+
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
 
@@ -11,20 +13,123 @@ const (
 	SNAKE_CASE NamingConventionEnum = "snake_case"
 )
 
+type QueryAPIEnum string
+
+const (
+	QUERY_API_REST QueryAPIEnum = "rest"
+	QUERY_API_BULK QueryAPIEnum = "bulk"
+)
+
+type LongQueryModeEnum string
+
+const (
+	LONG_QUERY_POST  LongQueryModeEnum = "post"
+	LONG_QUERY_ERROR LongQueryModeEnum = "error"
+)
+
+type ObjectNameCollisionEnum string
+
+const (
+	OBJECT_NAME_COLLISION_RENAME ObjectNameCollisionEnum = "rename"
+	OBJECT_NAME_COLLISION_SKIP   ObjectNameCollisionEnum = "skip"
+)
+
+type BooleanLiteralCaseEnum string
+
+const (
+	BOOLEAN_LITERAL_UPPER BooleanLiteralCaseEnum = "upper"
+	BOOLEAN_LITERAL_LOWER BooleanLiteralCaseEnum = "lower"
+)
+
+type AccessTokenURLMismatchEnum string
+
+const (
+	ACCESS_TOKEN_URL_MISMATCH_IGNORE AccessTokenURLMismatchEnum = "ignore"
+	ACCESS_TOKEN_URL_MISMATCH_WARN   AccessTokenURLMismatchEnum = "warn"
+	ACCESS_TOKEN_URL_MISMATCH_ERROR  AccessTokenURLMismatchEnum = "error"
+)
+
+type BlobFieldsEnum string
+
+const (
+	BLOB_FIELDS_CONTENT BlobFieldsEnum = "content"
+	BLOB_FIELDS_SKIP    BlobFieldsEnum = "skip"
+)
+
+type ChildRecordAttributesEnum string
+
+const (
+	CHILD_RECORD_ATTRIBUTES_KEEP  ChildRecordAttributesEnum = "keep"
+	CHILD_RECORD_ATTRIBUTES_STRIP ChildRecordAttributesEnum = "strip"
+)
+
 type salesforceConfig struct {
-	URL              *string               `hcl:"url"`
-	Username         *string               `hcl:"username"`
-	Password         *string               `hcl:"password"`
-	Token            *string               `hcl:"token"`
-	AccessToken      *string               `hcl:"access_token"`
-	RefreshToken     *string               `hcl:"refresh_token"`
-	ClientSecret     *string               `hcl:"client_secret"`
-	PrivateKey       *string               `hcl:"private_key"`
-	PrivateKeyFile   *string               `hcl:"private_key_file"`
-	ClientId         *string               `hcl:"client_id"`
-	APIVersion       *string               `hcl:"api_version"`
-	Objects          *[]string             `hcl:"objects"`
-	NamingConvention *NamingConventionEnum `hcl:"naming_convention"`
+	URL                     *string                     `hcl:"url"`
+	LoginURL                *string                     `hcl:"login_url"`
+	Username                *string                     `hcl:"username"`
+	Password                *string                     `hcl:"password"`
+	Token                   *string                     `hcl:"token"`
+	AccessToken             *string                     `hcl:"access_token"`
+	AccessTokenURLMismatch  *AccessTokenURLMismatchEnum `hcl:"access_token_url_mismatch"`
+	RefreshToken            *string                     `hcl:"refresh_token"`
+	ClientSecret            *string                     `hcl:"client_secret"`
+	PrivateKey              *string                     `hcl:"private_key"`
+	PrivateKeyFile          *string                     `hcl:"private_key_file"`
+	PrivateKeyPassphrase    *string                     `hcl:"private_key_passphrase"`
+	JWTAudience             *string                     `hcl:"jwt_audience"`
+	ProxyURL                *string                     `hcl:"proxy_url"`
+	ClientId                *string                     `hcl:"client_id"`
+	APIVersion              *string                     `hcl:"api_version"`
+	Objects                 *[]string                   `hcl:"objects"`
+	ObjectNameCollision     *ObjectNameCollisionEnum    `hcl:"object_name_collision"`
+	ObjectFields            []objectFieldsConfig        `hcl:"object_fields,block"`
+	BlobFields              *BlobFieldsEnum             `hcl:"blob_fields"`
+	BlobMaxBytes            *int                        `hcl:"blob_max_bytes"`
+	NamingConvention        *NamingConventionEnum       `hcl:"naming_convention"`
+	QueryAPI                *QueryAPIEnum               `hcl:"query_api"`
+	BulkThresholdRows       *int                        `hcl:"bulk_threshold_rows"`
+	LongQueryMode           *LongQueryModeEnum          `hcl:"long_query_mode"`
+	IncludeDeleted          *bool                       `hcl:"include_deleted"`
+	ChildRecordAttributes   *ChildRecordAttributesEnum  `hcl:"child_record_attributes"`
+	PKChunkSize             *int                        `hcl:"pk_chunk_size"`
+	BooleanLiteralCase      *BooleanLiteralCaseEnum     `hcl:"boolean_literal_case"`
+	RelativeDateLiterals    *bool                       `hcl:"relative_date_literals"`
+	RelationshipDepth       *int                        `hcl:"relationship_depth"`
+	ExposeCompoundFields    *bool                       `hcl:"expose_compound_fields"`
+	DescribeCacheTTLSeconds *int                        `hcl:"describe_cache_ttl_seconds"`
+	MaxAuthRetries          *int                        `hcl:"max_auth_retries"`
+	RetryBackoffMs          *int                        `hcl:"retry_backoff_ms"`
+	MaxRetries              *int                        `hcl:"max_retries"`
+	RetryBaseDelayMs        *int                        `hcl:"retry_base_delay_ms"`
+	QueryTimeoutSeconds     *int                        `hcl:"query_timeout_seconds"`
+	ObjectRetryPolicies     []objectRetryPolicyConfig   `hcl:"object_retry_policy,block"`
+}
+
+// objectRetryPolicyConfig overrides the connection-level retry settings for a
+// single Salesforce object, e.g.
+//
+//	object_retry_policy "Account" {
+//	  max_auth_retries = 3
+//	  retry_backoff_ms = 500
+//	}
+type objectRetryPolicyConfig struct {
+	Object         string `hcl:"object,label"`
+	MaxAuthRetries *int   `hcl:"max_auth_retries"`
+	RetryBackoffMs *int   `hcl:"retry_backoff_ms"`
+}
+
+// objectFieldsConfig restricts which fields of a single Salesforce object
+// become columns, e.g.
+//
+//	object_fields "Account" {
+//	  include = ["Name", "Industry", "OwnerId"]
+//	}
+//
+// Id is always kept.
+type objectFieldsConfig struct {
+	Object  string    `hcl:"object,label"`
+	Include *[]string `hcl:"include"`
+	Exclude *[]string `hcl:"exclude"`
 }
 
 func ConfigInstance() interface{} {
@@ -37,5 +142,25 @@ func GetConfig(connection *plugin.Connection) salesforceConfig {
 		return salesforceConfig{}
 	}
 	config, _ := connection.Config.(salesforceConfig)
+	return trimConfig(config)
+}
+
+// trimConfig strips surrounding whitespace from the url and objects entries,
+// which often creep in when values are copied from documentation. Blank
+// objects entries are dropped.
+func trimConfig(config salesforceConfig) salesforceConfig {
+	if config.URL != nil {
+		url := strings.TrimSpace(*config.URL)
+		config.URL = &url
+	}
+	if config.Objects != nil {
+		objects := []string{}
+		for _, object := range *config.Objects {
+			if object = strings.TrimSpace(object); object != "" {
+				objects = append(objects, object)
+			}
+		}
+		config.Objects = &objects
+	}
 	return config
 }
@@ -3,6 +3,7 @@
 package salesforce
 
 import (
+	"net/http"
 	"os"
 	"testing"
 
@@ -30,7 +31,7 @@ func loadEnvAndCreateClient(t *testing.T) *simpleforce.Client {
 		clientID = "steampipe"
 	}
 
-	apiVersion := simpleforce.DefaultAPIVersion
+	apiVersion := defaultAPIVersion
 
 	// Precedence 1: Pre-obtained access token
 	if accessToken != "" {
@@ -59,7 +60,7 @@ func loadEnvAndCreateClient(t *testing.T) *simpleforce.Client {
 			t.Fatal("SALESFORCE_REFRESH_TOKEN requires SALESFORCE_CLIENT_SECRET")
 		}
 		loginBase := loginURL(url)
-		at, instanceURL, err := refreshAccessToken(loginBase, clientID, clientSecret, refreshToken)
+		at, instanceURL, err := refreshAccessToken(http.DefaultClient, loginBase, clientID, clientSecret, refreshToken)
 		if err != nil {
 			t.Fatalf("refresh_token login failed: %v", err)
 		}
@@ -81,7 +82,7 @@ func loadEnvAndCreateClient(t *testing.T) *simpleforce.Client {
 			t.Fatalf("failed to load private key: %v", err)
 		}
 		loginBase := loginURL(url)
-		at, instanceURL, err := loginJWT(loginBase, clientID, username, pemKey)
+		at, instanceURL, err := loginJWT(http.DefaultClient, loginBase, "", clientID, username, pemKey)
 		if err != nil {
 			t.Fatalf("JWT login failed: %v", err)
 		}
@@ -0,0 +1,210 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// pkChunkConcurrency is the number of Id range queries of a chunked scan run
+// at the same time.
+const pkChunkConcurrency = 4
+
+// salesforceIDDigits are the digits of Salesforce IDs in ascending order,
+// which is also the order SOQL compares IDs in.
+const salesforceIDDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// pkChunkQueries returns the queries that read an unfiltered scan of tableName
+// in ranges of Id, or nil if the scan should run as a single query. Scans are
+// only chunked when pk_chunk_size is set and the object has more records than
+// that, and never when they are filtered, sorted or limited.
+func pkChunkQueries(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, tableName string, query string, condition string, orderBy string) []string {
+	config := GetConfig(d.Connection)
+	if config.PKChunkSize == nil || *config.PKChunkSize <= 0 || condition != "" || orderBy != "" || d.QueryContext.GetLimit() >= 0 {
+		return nil
+	}
+
+	count, err := countRecords(ctx, d, client, tableName, "")
+	if err != nil {
+		plugin.Logger(ctx).Warn("salesforce.pkChunkQueries", "msg", "unable to count records, scanning without chunks", "table_name", d.Table.Name, "error", err)
+		return nil
+	}
+	if count <= *config.PKChunkSize {
+		return nil
+	}
+
+	ids := []string{}
+	for _, order := range []string{"ASC", "DESC"} {
+		_, records, err := queryAllRecords(ctx, d, client, tableName, fmt.Sprintf("SELECT Id FROM %s ORDER BY Id %s LIMIT 1", tableName, order))
+		if err != nil || len(records) == 0 {
+			plugin.Logger(ctx).Warn("salesforce.pkChunkQueries", "msg", "unable to read the Id range, scanning without chunks", "table_name", d.Table.Name, "error", err)
+			return nil
+		}
+		id, _ := records[0]["Id"].(string)
+		ids = append(ids, id)
+	}
+
+	chunks := (count + *config.PKChunkSize - 1) / *config.PKChunkSize
+	boundaries, err := idRangeBoundaries(ids[0], ids[1], chunks)
+	if err != nil {
+		plugin.Logger(ctx).Warn("salesforce.pkChunkQueries", "msg", "unable to split the Id range, scanning without chunks", "table_name", d.Table.Name, "error", err)
+		return nil
+	}
+	plugin.Logger(ctx).Debug("salesforce.pkChunkQueries", "table_name", d.Table.Name, "record_count", count, "chunks", len(boundaries)+1)
+	return idRangeQueries(query, boundaries)
+}
+
+// idRangeQueries returns a query per range of Id between boundaries. The first
+// and last ranges are open, so records outside the Id range read before the
+// scan are still returned.
+func idRangeQueries(query string, boundaries []string) []string {
+	queries := []string{}
+	for i := 0; i <= len(boundaries); i++ {
+		conditions := []string{}
+		if i > 0 {
+			conditions = append(conditions, fmt.Sprintf("Id >= '%s'", boundaries[i-1]))
+		}
+		if i < len(boundaries) {
+			conditions = append(conditions, fmt.Sprintf("Id < '%s'", boundaries[i]))
+		}
+		queries = append(queries, fmt.Sprintf("%s where %s", query, strings.Join(conditions, " AND ")))
+	}
+	return queries
+}
+
+// idRangeBoundaries splits the IDs from minID to maxID into chunks ranges of
+// about the same size and returns the 18-character IDs between them. IDs
+// aren't allocated evenly, so ranges may hold different numbers of records.
+func idRangeBoundaries(minID string, maxID string, chunks int) ([]string, error) {
+	low, err := parseSalesforceID(minID)
+	if err != nil {
+		return nil, err
+	}
+	high, err := parseSalesforceID(maxID)
+	if err != nil {
+		return nil, err
+	}
+
+	span := new(big.Int).Sub(high, low)
+	boundaries := []string{}
+	for i := 1; i < chunks; i++ {
+		offset := new(big.Int).Mul(span, big.NewInt(int64(i)))
+		offset.Quo(offset, big.NewInt(int64(chunks)))
+		boundary := formatSalesforceID(new(big.Int).Add(low, offset))
+		if boundary > minID[:15] && (len(boundaries) == 0 || boundaries[len(boundaries)-1][:15] != boundary) {
+			boundaries = append(boundaries, boundary+salesforceIDSuffix(boundary))
+		}
+	}
+	return boundaries, nil
+}
+
+// parseSalesforceID returns the value of the case-sensitive 15-character form
+// of a Salesforce ID as a base 62 number.
+func parseSalesforceID(id string) (*big.Int, error) {
+	if len(id) != 15 && len(id) != 18 {
+		return nil, fmt.Errorf("invalid Salesforce ID %q", id)
+	}
+	value := new(big.Int)
+	for _, c := range id[:15] {
+		digit := strings.IndexRune(salesforceIDDigits, c)
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid Salesforce ID %q", id)
+		}
+		value.Mul(value, big.NewInt(62))
+		value.Add(value, big.NewInt(int64(digit)))
+	}
+	return value, nil
+}
+
+// formatSalesforceID returns the 15-character ID with the given value.
+func formatSalesforceID(value *big.Int) string {
+	id := make([]byte, 15)
+	rest := new(big.Int).Set(value)
+	digit := new(big.Int)
+	for i := len(id) - 1; i >= 0; i-- {
+		rest.QuoRem(rest, big.NewInt(62), digit)
+		id[i] = salesforceIDDigits[digit.Int64()]
+	}
+	return string(id)
+}
+
+// salesforceIDSuffix returns the 3 characters that make a 15-character ID a
+// case-insensitive 18-character one, encoding which of its characters are
+// upper case.
+func salesforceIDSuffix(id string) string {
+	const suffixDigits = "ABCDEFGHIJKLMNOPQRSTUVWXYZ012345"
+	suffix := make([]byte, 3)
+	for i := range suffix {
+		flags := 0
+		for j, c := range id[i*5 : i*5+5] {
+			if c >= 'A' && c <= 'Z' {
+				flags |= 1 << j
+			}
+		}
+		suffix[i] = suffixDigits[flags]
+	}
+	return string(suffix)
+}
+
+// runPKChunkQueries runs queries in parallel and passes each record to stream
+// as it arrives, so records of different ranges are interleaved. stream is
+// never called concurrently. Once it returns false the remaining queries are
+// cancelled.
+func runPKChunkQueries(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, tableName string, queries []string, stream func(record map[string]interface{}) bool) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var streamLock sync.Mutex
+	var errLock sync.Mutex
+	var firstErr error
+	stopped := false
+	limit := make(chan struct{}, pkChunkConcurrency)
+	for _, query := range queries {
+		wg.Add(1)
+		go func(query string) {
+			defer wg.Done()
+			select {
+			case limit <- struct{}{}:
+				defer func() { <-limit }()
+			case <-ctx.Done():
+				return
+			}
+
+			_, err := streamQueryRecords(ctx, d, client, tableName, query, func(record map[string]interface{}) bool {
+				streamLock.Lock()
+				defer streamLock.Unlock()
+				if stopped {
+					return false
+				}
+				if !stream(record) {
+					stopped = true
+					cancel()
+					return false
+				}
+				return true
+			})
+			if err != nil {
+				errLock.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				errLock.Unlock()
+				cancel()
+			}
+		}(query)
+	}
+	wg.Wait()
+
+	streamLock.Lock()
+	defer streamLock.Unlock()
+	if stopped {
+		return nil
+	}
+	return firstErr
+}
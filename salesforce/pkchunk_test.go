@@ -0,0 +1,166 @@
+package salesforce
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func TestSalesforceIDSuffix(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected string
+	}{
+		{"001000000000000", "AAA"},
+		{"001xx000003DGb2", "AAG"},
+		{"00570000001ZwTi", "AAK"},
+		{"ABCDEABCDEABCDE", "555"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			if got := salesforceIDSuffix(tt.id); got != tt.expected {
+				t.Errorf("salesforceIDSuffix(%q) = %q, want %q", tt.id, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseSalesforceID(t *testing.T) {
+	for _, id := range []string{"001000000000000", "001xx000003DGb2", "001xx000003DGb2AAG", "zzzzzzzzzzzzzzz"} {
+		value, err := parseSalesforceID(id)
+		if err != nil {
+			t.Fatalf("parseSalesforceID(%q): %v", id, err)
+		}
+		if got := formatSalesforceID(value); got != id[:15] {
+			t.Errorf("formatSalesforceID(parseSalesforceID(%q)) = %q, want %q", id, got, id[:15])
+		}
+	}
+
+	for _, id := range []string{"", "001xx", "001xx000003DG-2"} {
+		if _, err := parseSalesforceID(id); err == nil {
+			t.Errorf("parseSalesforceID(%q) = nil error, want an error", id)
+		}
+	}
+}
+
+func TestIDRangeBoundaries(t *testing.T) {
+	t.Run("even split", func(t *testing.T) {
+		// 100 in base 62 is 3844, so the boundaries are at 961, 1922 and 2883
+		got, err := idRangeBoundaries("001000000000000AAA", "001000000000100AAA", 4)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := []string{"0010000000000FVAAY", "0010000000000V0AAI", "0010000000000kVAAQ"}
+		if !slices.Equal(got, expected) {
+			t.Errorf("boundaries = %v, want %v", got, expected)
+		}
+	})
+
+	t.Run("ascending within the range", func(t *testing.T) {
+		minID, maxID := "001xx000003DGb2AAG", "001xx00000AbCdEAAS"
+		got, err := idRangeBoundaries(minID, maxID, 10)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got) != 9 || !slices.IsSorted(got) || got[0] <= minID || got[len(got)-1] >= maxID {
+			t.Errorf("boundaries = %v, want 9 ascending IDs between %s and %s", got, minID, maxID)
+		}
+	})
+
+	t.Run("narrow range", func(t *testing.T) {
+		got, err := idRangeBoundaries("001000000000000AAA", "001000000000002AAA", 10)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slices.Equal(got, []string{"001000000000001AAA"}) {
+			t.Errorf("boundaries = %v, want a single boundary without duplicates", got)
+		}
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		if _, err := idRangeBoundaries("001", "001000000000002AAA", 2); err == nil {
+			t.Error("expected an error for an invalid ID")
+		}
+	})
+}
+
+func TestIDRangeQueries(t *testing.T) {
+	got := idRangeQueries("SELECT Id FROM Account", []string{"001000000000100AAA", "001000000000200AAA"})
+	expected := []string{
+		"SELECT Id FROM Account where Id < '001000000000100AAA'",
+		"SELECT Id FROM Account where Id >= '001000000000100AAA' AND Id < '001000000000200AAA'",
+		"SELECT Id FROM Account where Id >= '001000000000200AAA'",
+	}
+	if !slices.Equal(got, expected) {
+		t.Errorf("queries = %q, want %q", got, expected)
+	}
+}
+
+func TestRunPKChunkQueries(t *testing.T) {
+	var buf bytes.Buffer
+	var lock sync.Mutex
+	queries := []string{}
+	// Each range query returns two records whose Id is the query's position
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query().Get("q")
+		lock.Lock()
+		queries = append(queries, q)
+		lock.Unlock()
+		chunk := q[strings.LastIndex(q, " ")+1:]
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"totalSize":2,"done":true,"records":[{"Id":"%s-1"},{"Id":"%s-2"}]}`, chunk, chunk)
+	}))
+	t.Cleanup(server.Close)
+
+	d := &plugin.QueryData{Connection: &plugin.Connection{Config: salesforceConfig{URL: stringPtr(server.URL), AccessToken: stringPtr("token")}}}
+	client, err := connect(contextWithLogger(&buf), d)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	chunkQueries := []string{}
+	for i := range 10 {
+		chunkQueries = append(chunkQueries, fmt.Sprintf("SELECT Id FROM Account where chunk %d", i))
+	}
+
+	t.Run("streams every record", func(t *testing.T) {
+		queries = nil
+		ids := []string{}
+		err := runPKChunkQueries(contextWithLogger(&buf), d, client, "Account", chunkQueries, func(record map[string]interface{}) bool {
+			ids = append(ids, record["Id"].(string))
+			return true
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(queries) != 10 || len(ids) != 20 {
+			t.Fatalf("queries = %d, records = %d, want 10 queries and 20 records", len(queries), len(ids))
+		}
+		// Records arrive in any order across ranges
+		for i := range 10 {
+			if !slices.Contains(ids, fmt.Sprintf("%d-1", i)) || !slices.Contains(ids, fmt.Sprintf("%d-2", i)) {
+				t.Errorf("records of range %d missing from %v", i, ids)
+			}
+		}
+	})
+
+	t.Run("stops once stream returns false", func(t *testing.T) {
+		streamed := 0
+		err := runPKChunkQueries(contextWithLogger(&buf), d, client, "Account", chunkQueries, func(record map[string]interface{}) bool {
+			streamed++
+			return false
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if streamed != 1 {
+			t.Errorf("streamed = %d, want 1", streamed)
+		}
+	})
+}
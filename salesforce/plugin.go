@@ -2,14 +2,15 @@ package salesforce
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 
 	"github.com/iancoleman/strcase"
 	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/connection"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
@@ -39,23 +40,30 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 	return p
 }
 
+// staticTables lists the Salesforce objects that have hand-defined tables.
+var staticTables = []string{"Account", "AccountContactRole", "Asset", "Contact", "Contract", "Lead", "Opportunity", "OpportunityContactRole", "Order", "Pricebook2", "Product2", "User", "PermissionSet", "PermissionSetAssignment", "ObjectPermissions", "ProcessInstance", "ProcessInstanceStep", "ProcessInstanceWorkitem", "OpportunityHistory", "AccountContactRelation", "Campaign", "CampaignMember", "PricebookEntry", "AuthSession", "RecentlyViewed", "Dashboard", "WorkOrder", "ServiceAppointment", "AssignedResource", "Quote", "QuoteLineItem", "Entitlement", "ServiceContract", "LoginHistory", "AssetRelationship", "OrderItem", "OpportunityFieldHistory"}
+
 type dynamicMap struct {
 	cols              []*plugin.Column
 	keyColumns        plugin.KeyColumnSlice
 	salesforceColumns map[string]string
+	// Lower-cased names of the fields the user can read, or nil if the object
+	// couldn't be described
+	readableFields map[string]bool
 }
 
 func pluginTableDefinitions(ctx context.Context, td *plugin.TableMapData) (map[string]*plugin.Table, error) {
-	// If unable to connect to salesforce instance, log warning and abort dynamic table creation
+	if warning := emptyConfigWarning(GetConfig(td.Connection)); warning != "" {
+		plugin.Logger(ctx).Warn("salesforce.pluginTableDefinitions", "msg", warning)
+	}
 
+	// If unable to connect to salesforce instance, log warning and abort dynamic table creation
 	client, err := connectRaw(ctx, td.ConnectionCache, td.Connection)
 	if err != nil {
 		// do not abort the plugin as static table needs to be generated
 		plugin.Logger(ctx).Warn("salesforce.pluginTableDefinitions", "connection_error: unable to generate dynamic tables because of invalid steampipe salesforce configuration", err)
 	}
 
-	staticTables := []string{"Account", "AccountContactRole", "Asset", "Contact", "Contract", "Lead", "Opportunity", "OpportunityContactRole", "Order", "Pricebook2", "Product2", "User", "PermissionSet", "PermissionSetAssignment", "ObjectPermissions"}
-
 	dynamicColumnsMap := map[string]dynamicMap{}
 	var mapLock sync.Mutex
 	config := GetConfig(td.Connection)
@@ -68,9 +76,9 @@ func pluginTableDefinitions(ctx context.Context, td *plugin.TableMapData) (map[s
 		for _, st := range staticTables {
 			go func(staticTable string) {
 				defer wgd.Done()
-				dynamicCols, dynamicKeyColumns, salesforceCols := dynamicColumns(ctx, client, staticTable, config)
+				dynamicCols, dynamicKeyColumns, salesforceCols, readableFields := dynamicColumns(ctx, td.ConnectionCache, client, staticTable, config)
 				mapLock.Lock()
-				dynamicColumnsMap[staticTable] = dynamicMap{dynamicCols, dynamicKeyColumns, salesforceCols}
+				dynamicColumnsMap[staticTable] = dynamicMap{dynamicCols, dynamicKeyColumns, salesforceCols, readableFields}
 				defer mapLock.Unlock()
 			}(st)
 		}
@@ -84,55 +92,131 @@ func pluginTableDefinitions(ctx context.Context, td *plugin.TableMapData) (map[s
 	if config.NamingConvention != nil && *config.NamingConvention == "api_native" {
 		tables = map[string]*plugin.Table{
 			"Account":                 SalesforceAccount(ctx, dynamicColumnsMap["Account"], config),
+			"AccountContactRelation":  SalesforceAccountContactRelation(ctx, dynamicColumnsMap["AccountContactRelation"], config),
 			"AccountContactRole":      SalesforceAccountContactRole(ctx, dynamicColumnsMap["AccountContactRole"], config),
 			"Asset":                   SalesforceAsset(ctx, dynamicColumnsMap["Asset"], config),
+			"AssetRelationship":       SalesforceAssetRelationship(ctx, dynamicColumnsMap["AssetRelationship"], config),
+			"AssignedResource":        SalesforceAssignedResource(ctx, dynamicColumnsMap["AssignedResource"], config),
+			"AuthSession":             SalesforceAuthSession(ctx, dynamicColumnsMap["AuthSession"], config),
+			"Campaign":                SalesforceCampaign(ctx, dynamicColumnsMap["Campaign"], config),
+			"CampaignMember":          SalesforceCampaignMember(ctx, dynamicColumnsMap["CampaignMember"], config),
 			"Contact":                 SalesforceContact(ctx, dynamicColumnsMap["Contact"], config),
 			"Contract":                SalesforceContract(ctx, dynamicColumnsMap["Contract"], config),
+			"Dashboard":               SalesforceDashboard(ctx, dynamicColumnsMap["Dashboard"], config),
+			"Entitlement":             SalesforceEntitlement(ctx, dynamicColumnsMap["Entitlement"], config),
 			"Lead":                    SalesforceLead(ctx, dynamicColumnsMap["Lead"], config),
+			"LoginHistory":            SalesforceUserLoginHistory(ctx, dynamicColumnsMap["LoginHistory"], config),
 			"ObjectPermissions":       SalesforceObjectPermission(ctx, dynamicColumnsMap["ObjectPermissions"], config),
 			"Opportunity":             SalesforceOpportunity(ctx, dynamicColumnsMap["Opportunity"], config),
 			"OpportunityContactRole":  SalesforceOpportunityContactRole(ctx, dynamicColumnsMap["OpportunityContactRole"], config),
+			"OpportunityFieldHistory": SalesforceOpportunityFieldHistory(ctx, dynamicColumnsMap["OpportunityFieldHistory"], config),
+			"OpportunityHistory":      SalesforceOpportunityHistory(ctx, dynamicColumnsMap["OpportunityHistory"], config),
 			"Order":                   SalesforceOrder(ctx, dynamicColumnsMap["Order"], config),
+			"OrderItem":               SalesforceOrderItem(ctx, dynamicColumnsMap["OrderItem"], config),
 			"PermissionSet":           SalesforcePermissionSet(ctx, dynamicColumnsMap["PermissionSet"], config),
 			"PermissionSetAssignment": SalesforcePermissionSetAssignment(ctx, dynamicColumnsMap["PermissionSetAssignment"], config),
 			"Pricebook2":              SalesforcePricebook(ctx, dynamicColumnsMap["Pricebook2"], config),
+			"PricebookEntry":          SalesforcePricebookEntry(ctx, dynamicColumnsMap["PricebookEntry"], config),
+			"ProcessInstance":         SalesforceProcessInstance(ctx, dynamicColumnsMap["ProcessInstance"], config),
+			"ProcessInstanceStep":     SalesforceProcessInstanceStep(ctx, dynamicColumnsMap["ProcessInstanceStep"], config),
+			"ProcessInstanceWorkitem": SalesforceProcessInstanceWorkitem(ctx, dynamicColumnsMap["ProcessInstanceWorkitem"], config),
 			"Product2":                SalesforceProduct(ctx, dynamicColumnsMap["Product2"], config),
+			"Quote":                   SalesforceQuote(ctx, dynamicColumnsMap["Quote"], config),
+			"QuoteLineItem":           SalesforceQuoteLineItem(ctx, dynamicColumnsMap["QuoteLineItem"], config),
+			"RecentlyViewed":          SalesforceRecentlyViewed(ctx, dynamicColumnsMap["RecentlyViewed"], config),
+			"ServiceAppointment":      SalesforceServiceAppointment(ctx, dynamicColumnsMap["ServiceAppointment"], config),
+			"ServiceContract":         SalesforceServiceContract(ctx, dynamicColumnsMap["ServiceContract"], config),
 			"User":                    SalesforceUser(ctx, dynamicColumnsMap["User"], config),
+			"WorkOrder":               SalesforceWorkOrder(ctx, dynamicColumnsMap["WorkOrder"], config),
 		}
 	} else {
 		tables = map[string]*plugin.Table{
 			"salesforce_account":                   SalesforceAccount(ctx, dynamicColumnsMap["Account"], config),
+			"salesforce_account_contact_relation":  SalesforceAccountContactRelation(ctx, dynamicColumnsMap["AccountContactRelation"], config),
 			"salesforce_account_contact_role":      SalesforceAccountContactRole(ctx, dynamicColumnsMap["AccountContactRole"], config),
 			"salesforce_asset":                     SalesforceAsset(ctx, dynamicColumnsMap["Asset"], config),
+			"salesforce_asset_relationship":        SalesforceAssetRelationship(ctx, dynamicColumnsMap["AssetRelationship"], config),
+			"salesforce_assigned_resource":         SalesforceAssignedResource(ctx, dynamicColumnsMap["AssignedResource"], config),
+			"salesforce_auth_session":              SalesforceAuthSession(ctx, dynamicColumnsMap["AuthSession"], config),
+			"salesforce_campaign":                  SalesforceCampaign(ctx, dynamicColumnsMap["Campaign"], config),
+			"salesforce_campaign_member":           SalesforceCampaignMember(ctx, dynamicColumnsMap["CampaignMember"], config),
 			"salesforce_contact":                   SalesforceContact(ctx, dynamicColumnsMap["Contact"], config),
 			"salesforce_contract":                  SalesforceContract(ctx, dynamicColumnsMap["Contract"], config),
+			"salesforce_dashboard":                 SalesforceDashboard(ctx, dynamicColumnsMap["Dashboard"], config),
+			"salesforce_entitlement":               SalesforceEntitlement(ctx, dynamicColumnsMap["Entitlement"], config),
 			"salesforce_lead":                      SalesforceLead(ctx, dynamicColumnsMap["Lead"], config),
 			"salesforce_object_permission":         SalesforceObjectPermission(ctx, dynamicColumnsMap["ObjectPermissions"], config),
 			"salesforce_opportunity":               SalesforceOpportunity(ctx, dynamicColumnsMap["Opportunity"], config),
 			"salesforce_opportunity_contact_role":  SalesforceOpportunityContactRole(ctx, dynamicColumnsMap["OpportunityContactRole"], config),
+			"salesforce_opportunity_field_history": SalesforceOpportunityFieldHistory(ctx, dynamicColumnsMap["OpportunityFieldHistory"], config),
+			"salesforce_opportunity_history":       SalesforceOpportunityHistory(ctx, dynamicColumnsMap["OpportunityHistory"], config),
 			"salesforce_order":                     SalesforceOrder(ctx, dynamicColumnsMap["Order"], config),
+			"salesforce_order_item":                SalesforceOrderItem(ctx, dynamicColumnsMap["OrderItem"], config),
 			"salesforce_permission_set":            SalesforcePermissionSet(ctx, dynamicColumnsMap["PermissionSet"], config),
 			"salesforce_permission_set_assignment": SalesforcePermissionSetAssignment(ctx, dynamicColumnsMap["PermissionSetAssignment"], config),
 			"salesforce_pricebook":                 SalesforcePricebook(ctx, dynamicColumnsMap["Pricebook2"], config),
+			"salesforce_pricebook_entry":           SalesforcePricebookEntry(ctx, dynamicColumnsMap["PricebookEntry"], config),
+			"salesforce_process_instance":          SalesforceProcessInstance(ctx, dynamicColumnsMap["ProcessInstance"], config),
+			"salesforce_process_instance_step":     SalesforceProcessInstanceStep(ctx, dynamicColumnsMap["ProcessInstanceStep"], config),
+			"salesforce_process_instance_workitem": SalesforceProcessInstanceWorkitem(ctx, dynamicColumnsMap["ProcessInstanceWorkitem"], config),
 			"salesforce_product":                   SalesforceProduct(ctx, dynamicColumnsMap["Product2"], config),
+			"salesforce_quote":                     SalesforceQuote(ctx, dynamicColumnsMap["Quote"], config),
+			"salesforce_quote_line_item":           SalesforceQuoteLineItem(ctx, dynamicColumnsMap["QuoteLineItem"], config),
+			"salesforce_recently_viewed":           SalesforceRecentlyViewed(ctx, dynamicColumnsMap["RecentlyViewed"], config),
+			"salesforce_service_appointment":       SalesforceServiceAppointment(ctx, dynamicColumnsMap["ServiceAppointment"], config),
+			"salesforce_service_contract":          SalesforceServiceContract(ctx, dynamicColumnsMap["ServiceContract"], config),
 			"salesforce_user":                      SalesforceUser(ctx, dynamicColumnsMap["User"], config),
+			"salesforce_user_login_history":        SalesforceUserLoginHistory(ctx, dynamicColumnsMap["LoginHistory"], config),
+			"salesforce_work_order":                SalesforceWorkOrder(ctx, dynamicColumnsMap["WorkOrder"], config),
 		}
 	}
 
-	var re = regexp.MustCompile(`\d+`)
-	var substitution = ``
-	salesforceTables := []string{}
+	// Tables that aren't backed by a single Salesforce object keep the same
+	// name regardless of the naming convention
+	tables["salesforce_apex_class"] = SalesforceApexClass(ctx, config)
+	tables["salesforce_apex_trigger"] = SalesforceApexTrigger(ctx, config)
+	tables["salesforce_connection"] = SalesforceConnection(ctx, config)
+	tables["salesforce_field"] = SalesforceField(ctx, config)
+	tables["salesforce_field_permission"] = SalesforceFieldPermission(ctx, config)
+	tables["salesforce_flow"] = SalesforceFlow(ctx, config)
+	tables["salesforce_flow_version"] = SalesforceFlowVersion(ctx, config)
+	tables["salesforce_group_member"] = SalesforceGroupMember(ctx, config)
+	tables["salesforce_limits"] = SalesforceLimits(ctx, config)
+	tables["salesforce_object_relationship"] = SalesforceObjectRelationship(ctx, config)
+	tables["salesforce_permission_set_group"] = SalesforcePermissionSetGroup(ctx, config)
+	tables["salesforce_picklist_value"] = SalesforcePicklistValue(ctx, config)
+	tables["salesforce_product_category"] = SalesforceProductCategory(ctx, config)
+	tables["salesforce_product_category_product"] = SalesforceProductCategoryProduct(ctx, config)
+	tables["salesforce_query"] = SalesforceQuery(ctx, config)
+	tables["salesforce_record_count"] = SalesforceRecordCount(ctx, config)
+	tables["salesforce_report_subscription"] = SalesforceReportSubscription(ctx, config)
+	tables["salesforce_sobject"] = SalesforceSObject(ctx, config)
+	tables["salesforce_storage_usage"] = SalesforceStorageUsage(ctx, config)
+	tables["salesforce_territory_assignment_rule"] = SalesforceTerritoryAssignmentRule(ctx, config)
+	tables["salesforce_tooling_query"] = SalesforceToolingQuery(ctx, config)
+
+	// Table names of the objects listed in objects that don't have a table yet
+	salesforceTables := map[string]string{}
 	if config.Objects != nil && len(*config.Objects) > 0 {
-		for _, tableName := range *config.Objects {
-			var pluginTableName string
-			if config.NamingConvention != nil && *config.NamingConvention == "api_native" {
-				pluginTableName = strcase.ToSnake(re.ReplaceAllString(tableName, substitution))
-			} else {
-				pluginTableName = "salesforce_" + strcase.ToSnake(re.ReplaceAllString(tableName, substitution))
+		taken := func(tableName string) bool {
+			_, isTable := tables[tableName]
+			_, isObject := salesforceTables[tableName]
+			return isTable || isObject
+		}
+		listed := map[string]bool{}
+		for _, objectName := range *config.Objects {
+			if listed[objectName] {
+				continue
 			}
-			if _, ok := tables[pluginTableName]; !ok {
-				salesforceTables = append(salesforceTables, tableName)
+			listed[objectName] = true
+			tableName, ok := objectTableName(config, objectName, taken)
+			if !ok {
+				continue
 			}
+			if defaultName := defaultObjectTableName(config, objectName); tableName != defaultName {
+				plugin.Logger(ctx).Warn("salesforce.pluginTableDefinitions", "msg", "table name is already taken, renaming the object's table", "object_name", objectName, "default_table_name", defaultName, "table_name", tableName)
+			}
+			salesforceTables[tableName] = objectName
 		}
 	}
 
@@ -142,45 +226,87 @@ func pluginTableDefinitions(ctx context.Context, td *plugin.TableMapData) (map[s
 	}
 
 	var wg sync.WaitGroup
-	wg.Add(len(salesforceTables))
-	for _, sfTable := range salesforceTables {
-		var tableName string
-		if config.NamingConvention != nil && *config.NamingConvention == "api_native" {
-			tableName = sfTable
-		} else {
-			tableName = "salesforce_" + strcase.ToSnake(re.ReplaceAllString(sfTable, substitution))
-		}
-		if tables[tableName] != nil {
-			wg.Done()
-			continue
-		}
-		go func(name string) {
+	var tablesLock sync.Mutex
+	for tableName, objectName := range salesforceTables {
+		wg.Add(1)
+		go func(tableName string, objectName string) {
 			defer wg.Done()
-			if config.NamingConvention != nil && *config.NamingConvention == "api_native" {
-				tableName = name
-			} else {
-				tableName = "salesforce_" + strcase.ToSnake(re.ReplaceAllString(name, substitution))
-			}
-			plugin.Logger(ctx).Debug("salesforce.pluginTableDefinitions", "object_name", name, "table_name", tableName)
-			ctx = context.WithValue(ctx, contextKey("PluginTableName"), tableName)
-			ctx = context.WithValue(ctx, contextKey("SalesforceTableName"), name)
-			table := generateDynamicTables(ctx, client, config)
+			plugin.Logger(ctx).Debug("salesforce.pluginTableDefinitions", "object_name", objectName, "table_name", tableName)
+			ctx := context.WithValue(ctx, contextKey("PluginTableName"), tableName)
+			ctx = context.WithValue(ctx, contextKey("SalesforceTableName"), objectName)
+			table := generateDynamicTables(ctx, td.ConnectionCache, client, config)
 			// Ignore if the requested Salesforce object is not present.
 			if table != nil {
+				tablesLock.Lock()
 				tables[tableName] = table
+				tablesLock.Unlock()
 			}
-		}(sfTable)
+		}(tableName, objectName)
 	}
 	wg.Wait()
 	return tables, nil
 }
 
-func generateDynamicTables(ctx context.Context, client *simpleforce.Client, config salesforceConfig) *plugin.Table {
+// emptyConfigWarning returns how to configure a connection that can't define
+// more than the static columns of the built-in tables, because it has no
+// credentials to describe objects with, or an empty string if it has them.
+// Objects aren't discovered, so they must be listed in objects to get tables.
+func emptyConfigWarning(config salesforceConfig) string {
+	if authMethod(config) != "" {
+		return ""
+	}
+	credentials := "set url and access_token, refresh_token, private_key/private_key_file or username/password in the connection config"
+	if config.Objects == nil || len(*config.Objects) == 0 {
+		return "no credentials and no objects are configured, so tables only have their built-in columns and no other objects can be queried: " + credentials + `, and list the objects to query in objects, e.g. objects = ["CustomApp__c"]`
+	}
+	return "no credentials are configured, so the tables of the objects in objects can't be defined and the built-in tables only have their static columns: " + credentials
+}
+
+// collidingTableSuffix is appended to the table name of an object listed in
+// objects when the name is already taken.
+const collidingTableSuffix = "_object"
+
+var digitsRegexp = regexp.MustCompile(`\d+`)
+
+// defaultObjectTableName returns the table name of a Salesforce object for the
+// naming convention, e.g. salesforce_widget for Widget2__c in snake_case.
+func defaultObjectTableName(config salesforceConfig, objectName string) string {
+	if config.NamingConvention != nil && *config.NamingConvention == API_NATIVE {
+		return objectName
+	}
+	return "salesforce_" + strcase.ToSnake(digitsRegexp.ReplaceAllString(objectName, ""))
+}
+
+// objectTableName returns the name of the table for an object listed in
+// objects, or false if it doesn't get a table of its own. Objects with a
+// hand-defined table, such as Account, are skipped. If the default name is
+// already taken, e.g. GroupMember by salesforce_group_member or Survey2__c by
+// Survey1__c, "_object" is appended to it, unless object_name_collision is
+// "skip".
+func objectTableName(config salesforceConfig, objectName string, taken func(string) bool) (string, bool) {
+	if slices.ContainsFunc(staticTables, func(staticTable string) bool { return strings.EqualFold(staticTable, objectName) }) {
+		return "", false
+	}
+	tableName := defaultObjectTableName(config, objectName)
+	if !taken(tableName) {
+		return tableName, true
+	}
+	if config.ObjectNameCollision != nil && *config.ObjectNameCollision == OBJECT_NAME_COLLISION_SKIP {
+		return "", false
+	}
+	tableName += collidingTableSuffix
+	if taken(tableName) {
+		return "", false
+	}
+	return tableName, true
+}
+
+func generateDynamicTables(ctx context.Context, cc *connection.ConnectionCache, client *simpleforce.Client, config salesforceConfig) *plugin.Table {
 	// Get the query for the metric (required)
 	salesforceTableName := ctx.Value(contextKey("SalesforceTableName")).(string)
 	tableName := ctx.Value(contextKey("PluginTableName")).(string)
 
-	sObjectMeta := client.SObject(salesforceTableName).Describe()
+	sObjectMeta := describeSObject(ctx, cc, client, config, salesforceTableName)
 	if sObjectMeta == nil {
 		plugin.Logger(ctx).Error("salesforce.generateDynamicTables", fmt.Sprintf("Object %s not found in salesforce", salesforceTableName))
 		return nil
@@ -191,34 +317,30 @@ func generateDynamicTables(ctx context.Context, client *simpleforce.Client, conf
 	salesforceCols := map[string]string{}
 	// Key columns
 	keyColumns := plugin.KeyColumnSlice{}
+	// Column names already generated, to skip fields that collide with them
+	fieldsByColumn := map[string]string{"organization_id": ""}
 
-	salesforceObjectMetadata := *sObjectMeta
-	salesforceObjectMetadataAsByte, err := json.Marshal(salesforceObjectMetadata["fields"])
-	if err != nil {
-		plugin.Logger(ctx).Error("salesforce.generateDynamicTables", "json marshal error", err)
-	}
-
-	salesforceObjectFields := []map[string]interface{}{}
-	err = json.Unmarshal(salesforceObjectMetadataAsByte, &salesforceObjectFields)
+	fields, err := describeFields(sObjectMeta)
 	if err != nil {
-		plugin.Logger(ctx).Error("salesforce.generateDynamicTables", "json unmarshal error", err)
+		plugin.Logger(ctx).Error("salesforce.generateDynamicTables", "describe decoding error", err)
 	}
-
-	for _, properties := range salesforceObjectFields {
-		if properties["name"] == nil {
+	fields = accessibleFields(ctx, salesforceTableName, fields)
+	currencyCodeColumn := currencyColumn(config, salesforceTableName, fields)
+	for _, field := range fields {
+		if field.Name == "" || field.SoapType == "" {
 			continue
 		}
-		fieldName := properties["name"].(string)
-		compoundFieldName := properties["compoundFieldName"]
-		if compoundFieldName != nil && compoundFieldName.(string) != fieldName {
+		fieldName := field.Name
+		if !isFieldIncluded(config, salesforceTableName, fieldName) {
 			continue
 		}
-
-		if properties["soapType"] == nil {
+		if field.isCompoundComponent() && !exposeCompoundFields(config) {
+			continue
+		}
+		fieldType := field.soapType()
+		if field.isBlob() && skipBlobFields(config) {
 			continue
 		}
-		soapType := strings.Split((properties["soapType"]).(string), ":")
-		fieldType := soapType[len(soapType)-1]
 
 		// Column dynamic generation
 		// Don't convert to snake case since field names can have underscores in
@@ -230,46 +352,57 @@ func generateDynamicTables(ctx context.Context, client *simpleforce.Client, conf
 		// keep the field name as it is if NamingConvention is set to api_native
 		if config.NamingConvention != nil && *config.NamingConvention == "api_native" {
 			columnFieldName = fieldName
-		} else if strings.HasSuffix(fieldName, "__c") {
+		} else if isCustomFieldName(fieldName) {
 			columnFieldName = strings.ToLower(fieldName)
 		} else {
 			columnFieldName = strcase.ToSnake(fieldName)
 		}
 
+		if isBlankColumnName(columnFieldName) {
+			plugin.Logger(ctx).Warn("salesforce.generateDynamicTables", "msg", "skipping field with blank column name", "table", salesforceTableName, "field_name", fieldName)
+			continue
+		}
+
+		// Different fields (e.g. "TestField" and "Test_Field") can map to the
+		// same column name, so keep the first one
+		if firstField, ok := fieldsByColumn[columnFieldName]; ok {
+			plugin.Logger(ctx).Warn("salesforce.generateDynamicTables", "msg", "skipping field with duplicate column name", "table", salesforceTableName, "field_name", fieldName, "column_name", columnFieldName, "first_field_name", firstField)
+			continue
+		}
+		fieldsByColumn[columnFieldName] = fieldName
+
+		// The content of base64 fields is read from their own resource
+		if field.isBlob() {
+			cols = append(cols, blobColumn(salesforceTableName, fieldName, columnFieldName, field.Label))
+			continue
+		}
+
 		column := plugin.Column{
 			Name:        columnFieldName,
-			Description: fmt.Sprintf("%s.", properties["label"].(string)),
+			Description: field.description(currencyCodeColumn),
 			Transform:   transform.FromP(getFieldFromSObjectMap, fieldName),
 		}
 		// Adding column type in the map to help in qual handling
 		salesforceCols[columnFieldName] = fieldType
 
 		// Set column type based on the `soapType` from salesforce schema
-		switch fieldType {
-		case "string", "ID", "time":
-			column.Type = proto.ColumnType_STRING
-			keyColumns = append(keyColumns, &plugin.KeyColumn{Name: columnFieldName, Require: plugin.Optional, Operators: []string{"=", "<>"}})
-		case "date", "dateTime":
-			column.Type = proto.ColumnType_TIMESTAMP
-			keyColumns = append(keyColumns, &plugin.KeyColumn{Name: columnFieldName, Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}})
-		case "boolean":
-			column.Type = proto.ColumnType_BOOL
-			keyColumns = append(keyColumns, &plugin.KeyColumn{Name: columnFieldName, Require: plugin.Optional, Operators: []string{"=", "<>"}})
-		case "double":
-			column.Type = proto.ColumnType_DOUBLE
-			keyColumns = append(keyColumns, &plugin.KeyColumn{Name: columnFieldName, Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}})
-		case "int":
-			column.Type = proto.ColumnType_INT
-			keyColumns = append(keyColumns, &plugin.KeyColumn{Name: columnFieldName, Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}})
-		default:
-			column.Type = proto.ColumnType_JSON
+		var operators []string
+		column.Type, operators = columnTypeFromSoapType(ctx, fieldName, fieldType)
+		operators = field.pushdownOperators(operators)
+		if len(operators) > 0 {
+			keyColumns = append(keyColumns, &plugin.KeyColumn{Name: columnFieldName, Require: plugin.Optional, Operators: operators})
+		}
+		// Let Steampipe push ORDER BY down for fields SOQL can sort on
+		if field.Sortable && column.Type != proto.ColumnType_JSON {
+			column.Sort = plugin.SortAll
 		}
 		cols = append(cols, &column)
 	}
+	cols = append(cols, relationshipColumns(ctx, cc, client, sObjectMeta, config, fieldsByColumn)...)
 
 	Table := plugin.Table{
 		Name:        tableName,
-		Description: fmt.Sprintf("Represents Salesforce object %s.", salesforceObjectMetadata["name"]),
+		Description: fmt.Sprintf("Represents Salesforce object %s.", (*sObjectMeta)["name"]),
 		List: &plugin.ListConfig{
 			KeyColumns: keyColumns,
 			Hydrate:    listSalesforceObjectsByTable(salesforceTableName, salesforceCols),
@@ -293,3 +426,13 @@ func checkNameScheme(config salesforceConfig, dynamicColumns []*plugin.Column) s
 	}
 	return "id"
 }
+
+// checkColumnNameScheme returns the name of the column holding the field with
+// the given snake_case column name, e.g. CampaignId for campaign_id when the
+// naming convention is api_native.
+func checkColumnNameScheme(config salesforceConfig, dynamicColumns []*plugin.Column, columnName string) string {
+	if config.NamingConvention != nil && *config.NamingConvention == "api_native" && len(dynamicColumns) > 0 {
+		return getSalesforceColumnName(columnName)
+	}
+	return columnName
+}
@@ -1,11 +1,17 @@
 package salesforce
 
 import (
+	"bytes"
 	"context"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func strPtr(s string) *NamingConventionEnum {
@@ -72,7 +78,7 @@ func TestMergeTableColumns(t *testing.T) {
 			{Name: "id", Type: proto.ColumnType_STRING},   // duplicate
 			{Name: "email", Type: proto.ColumnType_STRING}, // new
 		}
-		got := mergeTableColumns(ctx, config, dynamic, static)
+		got := mergeTableColumns(ctx, config, dynamicMap{cols: dynamic}, static)
 
 		// Should have id, name (from static) + email (from dynamic)
 		if len(got) != 3 {
@@ -89,6 +95,30 @@ func TestMergeTableColumns(t *testing.T) {
 		}
 	})
 
+	t.Run("static columns take sortability from dynamic columns", func(t *testing.T) {
+		config := salesforceConfig{NamingConvention: nil}
+		static := []*plugin.Column{
+			{Name: "name", Type: proto.ColumnType_STRING},
+			{Name: "billing_address", Type: proto.ColumnType_JSON},
+			{Name: "custom_only", Type: proto.ColumnType_STRING},
+		}
+		dynamic := []*plugin.Column{
+			{Name: "name", Type: proto.ColumnType_STRING, Sort: plugin.SortAll},
+			{Name: "billing_address", Type: proto.ColumnType_STRING, Sort: plugin.SortAll},
+		}
+		got := mergeTableColumns(ctx, config, dynamicMap{cols: dynamic}, static)
+
+		if got[0].Sort != plugin.SortAll {
+			t.Errorf("name Sort = %v, want %v", got[0].Sort, plugin.SortAll)
+		}
+		if got[1].Sort != plugin.SortNone {
+			t.Errorf("billing_address Sort = %v, want JSON columns to stay unsortable", got[1].Sort)
+		}
+		if got[2].Sort != plugin.SortNone {
+			t.Errorf("custom_only Sort = %v, want %v", got[2].Sort, plugin.SortNone)
+		}
+	})
+
 	t.Run("api_native returns only dynamic", func(t *testing.T) {
 		config := salesforceConfig{NamingConvention: strPtr("api_native")}
 		static := []*plugin.Column{
@@ -98,7 +128,7 @@ func TestMergeTableColumns(t *testing.T) {
 			{Name: "Id", Type: proto.ColumnType_STRING},
 			{Name: "Name", Type: proto.ColumnType_STRING},
 		}
-		got := mergeTableColumns(ctx, config, dynamic, static)
+		got := mergeTableColumns(ctx, config, dynamicMap{cols: dynamic}, static)
 
 		if len(got) != 2 {
 			t.Fatalf("len = %d, want 2", len(got))
@@ -115,7 +145,7 @@ func TestMergeTableColumns(t *testing.T) {
 			{Name: "name", Type: proto.ColumnType_STRING},
 		}
 		dynamic := []*plugin.Column{}
-		got := mergeTableColumns(ctx, config, dynamic, static)
+		got := mergeTableColumns(ctx, config, dynamicMap{cols: dynamic}, static)
 
 		// api_native with empty dynamic falls through to default path
 		if len(got) != 2 {
@@ -126,12 +156,41 @@ func TestMergeTableColumns(t *testing.T) {
 		}
 	})
 
+	t.Run("static columns of unreadable fields are dropped", func(t *testing.T) {
+		// AnnualRevenue isn't described, and Industry isn't accessible
+		fields := `[
+			{"name":"Id","type":"id","soapType":"tns:ID"},
+			{"name":"Name","type":"string","soapType":"xsd:string"},
+			{"name":"Industry","type":"picklist","soapType":"xsd:string","accessible":false},
+			{"name":"Rating","type":"picklist","soapType":"xsd:string","accessible":true}
+		]`
+		exclude := []string{"Rating"}
+		config := salesforceConfig{ObjectFields: []objectFieldsConfig{{Object: "Account", Exclude: &exclude}}}
+		var buf bytes.Buffer
+		cols, keyColumns, salesforceCols, readableFields := dynamicColumns(contextWithLogger(&buf), nil, newDescribeClient(t, fields), "Account", config)
+		table := SalesforceAccount(contextWithLogger(&buf), dynamicMap{cols, keyColumns, salesforceCols, readableFields}, config)
+
+		for _, name := range []string{"id", "name", "rating"} {
+			if !hasColumn(table.Columns, name) {
+				t.Errorf("missing column %q", name)
+			}
+		}
+		for _, name := range []string{"annual_revenue", "industry"} {
+			if hasColumn(table.Columns, name) {
+				t.Errorf("column %q of an unreadable field should be dropped", name)
+			}
+		}
+		if !strings.Contains(buf.String(), "annual_revenue,industry") {
+			t.Errorf("expected debug log of the dropped columns, got %q", buf.String())
+		}
+	})
+
 	t.Run("empty dynamic returns static only", func(t *testing.T) {
 		config := salesforceConfig{}
 		static := []*plugin.Column{
 			{Name: "id", Type: proto.ColumnType_STRING},
 		}
-		got := mergeTableColumns(ctx, config, []*plugin.Column{}, static)
+		got := mergeTableColumns(ctx, config, dynamicMap{}, static)
 		if len(got) != 1 {
 			t.Fatalf("len = %d, want 1", len(got))
 		}
@@ -140,3 +199,574 @@ func TestMergeTableColumns(t *testing.T) {
 		}
 	})
 }
+
+// hasColumn reports whether a column with the given name exists in cols
+func hasColumn(cols []*plugin.Column, name string) bool {
+	for _, c := range cols {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestStaticTableColumns(t *testing.T) {
+	ctx := context.Background()
+	config := salesforceConfig{}
+
+	tests := []struct {
+		name     string
+		table    *plugin.Table
+		expected []string
+	}{
+		{
+			name:     "salesforce_process_instance",
+			table:    SalesforceProcessInstance(ctx, dynamicMap{}, config),
+			expected: []string{"id", "status", "target_object_id", "process_definition_id", "last_actor_id", "completed_date"},
+		},
+		{
+			name:     "salesforce_process_instance_step",
+			table:    SalesforceProcessInstanceStep(ctx, dynamicMap{}, config),
+			expected: []string{"id", "process_instance_id", "step_status", "actor_id", "original_actor_id", "step_node_id"},
+		},
+		{
+			name:     "salesforce_process_instance_workitem",
+			table:    SalesforceProcessInstanceWorkitem(ctx, dynamicMap{}, config),
+			expected: []string{"id", "process_instance_id", "actor_id", "original_actor_id"},
+		},
+		{
+			name:     "salesforce_opportunity_history",
+			table:    SalesforceOpportunityHistory(ctx, dynamicMap{}, config),
+			expected: []string{"id", "opportunity_id", "stage_name", "amount", "probability", "created_date"},
+		},
+		{
+			name:     "salesforce_opportunity_field_history",
+			table:    SalesforceOpportunityFieldHistory(ctx, dynamicMap{}, config),
+			expected: []string{"id", "opportunity_id", "field", "old_value", "new_value", "created_date"},
+		},
+		{
+			name:     "salesforce_account_contact_relation",
+			table:    SalesforceAccountContactRelation(ctx, dynamicMap{}, config),
+			expected: []string{"id", "account_id", "contact_id", "roles", "is_direct"},
+		},
+		{
+			name:     "salesforce_campaign",
+			table:    SalesforceCampaign(ctx, dynamicMap{}, config),
+			expected: []string{"id", "name", "type", "status", "is_active", "budgeted_cost", "actual_cost", "expected_revenue", "parent_id"},
+		},
+		{
+			name:     "salesforce_campaign_member",
+			table:    SalesforceCampaignMember(ctx, dynamicMap{}, config),
+			expected: []string{"id", "campaign_id", "lead_or_contact_id", "type", "status", "has_responded"},
+		},
+		{
+			name:     "salesforce_pricebook_entry",
+			table:    SalesforcePricebookEntry(ctx, dynamicMap{}, config),
+			expected: []string{"id", "name", "pricebook_2_id", "product_2_id", "unit_price", "is_active"},
+		},
+		{
+			name:     "salesforce_dashboard",
+			table:    SalesforceDashboard(ctx, dynamicMap{}, config),
+			expected: []string{"id", "title", "developer_name", "folder_id", "folder_name", "running_user_id", "last_refresh_date"},
+		},
+		{
+			name:     "salesforce_work_order",
+			table:    SalesforceWorkOrder(ctx, dynamicMap{}, config),
+			expected: []string{"id", "work_order_number", "subject", "status", "status_category", "priority", "start_date", "end_date"},
+		},
+		{
+			name:     "salesforce_service_appointment",
+			table:    SalesforceServiceAppointment(ctx, dynamicMap{}, config),
+			expected: []string{"id", "appointment_number", "parent_record_id", "status", "sched_start_time", "sched_end_time", "actual_start_time", "actual_end_time"},
+		},
+		{
+			name:     "salesforce_assigned_resource",
+			table:    SalesforceAssignedResource(ctx, dynamicMap{}, config),
+			expected: []string{"id", "service_appointment_id", "service_resource_id"},
+		},
+		{
+			name:     "salesforce_quote",
+			table:    SalesforceQuote(ctx, dynamicMap{}, config),
+			expected: []string{"id", "name", "quote_number", "status", "opportunity_id", "grand_total", "total_price"},
+		},
+		{
+			name:     "salesforce_quote_line_item",
+			table:    SalesforceQuoteLineItem(ctx, dynamicMap{}, config),
+			expected: []string{"id", "quote_id", "line_number", "product_2_id", "quantity", "unit_price", "total_price"},
+		},
+		{
+			name:     "salesforce_entitlement",
+			table:    SalesforceEntitlement(ctx, dynamicMap{}, config),
+			expected: []string{"id", "name", "account_id", "status", "start_date", "end_date"},
+		},
+		{
+			name:     "salesforce_service_contract",
+			table:    SalesforceServiceContract(ctx, dynamicMap{}, config),
+			expected: []string{"id", "name", "contract_number", "account_id", "status", "start_date", "end_date"},
+		},
+		{
+			name:     "salesforce_user_login_history",
+			table:    SalesforceUserLoginHistory(ctx, dynamicMap{}, config),
+			expected: []string{"id", "user_id", "login_time", "source_ip", "login_type", "status", "browser", "platform", "application"},
+		},
+		{
+			name:     "salesforce_permission_set",
+			table:    SalesforcePermissionSet(ctx, dynamicMap{}, config),
+			expected: []string{"id", "name", "label", "is_owned_by_profile", "profile_id", "permission_set_group_id"},
+		},
+		{
+			name:     "salesforce_permission_set_assignment",
+			table:    SalesforcePermissionSetAssignment(ctx, dynamicMap{}, config),
+			expected: []string{"id", "assignee_id", "permission_set_id", "permission_set_group_id"},
+		},
+		{
+			name:     "salesforce_asset",
+			table:    SalesforceAsset(ctx, dynamicMap{}, config),
+			expected: []string{"id", "name", "account_id", "status", "install_date", "product_2_id", "serial_number"},
+		},
+		{
+			name:     "salesforce_asset_relationship",
+			table:    SalesforceAssetRelationship(ctx, dynamicMap{}, config),
+			expected: []string{"id", "asset_id", "related_asset_id", "relationship_type", "from_date", "to_date"},
+		},
+		{
+			name:     "salesforce_order",
+			table:    SalesforceOrder(ctx, dynamicMap{}, config),
+			expected: []string{"id", "name", "account_id", "order_number", "owner_id", "status", "total_amount", "type"},
+		},
+		{
+			name:     "salesforce_order_item",
+			table:    SalesforceOrderItem(ctx, dynamicMap{}, config),
+			expected: []string{"id", "order_id", "order_item_number", "product_2_id", "quantity", "unit_price", "total_price"},
+		},
+		{
+			name:     "salesforce_lead",
+			table:    SalesforceLead(ctx, dynamicMap{}, config),
+			expected: []string{"id", "is_converted", "converted_account_id", "converted_contact_id", "converted_opportunity_id", "converted_date"},
+		},
+		{
+			name:     "salesforce_auth_session",
+			table:    SalesforceAuthSession(ctx, dynamicMap{}, config),
+			expected: []string{"id", "users_id", "login_type", "session_type", "created_date", "source_ip"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.table.Name != tt.name {
+				t.Errorf("Name = %q, want %q", tt.table.Name, tt.name)
+			}
+			if tt.table.Get == nil || tt.table.List == nil {
+				t.Fatal("expected both List and Get to be defined")
+			}
+			for _, col := range tt.expected {
+				if !hasColumn(tt.table.Columns, col) {
+					t.Errorf("missing column %q", col)
+				}
+			}
+		})
+	}
+}
+
+func TestRecentlyViewedTable(t *testing.T) {
+	table := SalesforceRecentlyViewed(context.Background(), dynamicMap{}, salesforceConfig{})
+	if table.Name != "salesforce_recently_viewed" {
+		t.Errorf("Name = %q, want %q", table.Name, "salesforce_recently_viewed")
+	}
+	// RecentlyViewed can't be retrieved by ID
+	if table.List == nil || table.Get != nil {
+		t.Fatal("expected List and no Get")
+	}
+	for _, col := range []string{"id", "name", "type", "last_viewed_date", "last_referenced_date"} {
+		if !hasColumn(table.Columns, col) {
+			t.Errorf("missing column %q", col)
+		}
+	}
+}
+
+func TestCampaignMemberKeyColumns(t *testing.T) {
+	ctx := context.Background()
+	keyColumn := func(keyColumns plugin.KeyColumnSlice, name string) *plugin.KeyColumn {
+		for _, keyColumn := range keyColumns {
+			if keyColumn.Name == name {
+				return keyColumn
+			}
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name       string
+		config     salesforceConfig
+		dm         dynamicMap
+		campaignID string
+	}{
+		{
+			name:   "described",
+			config: salesforceConfig{},
+			dm: dynamicMap{
+				cols: []*plugin.Column{{Name: "campaign_id"}, {Name: "status"}},
+				keyColumns: plugin.KeyColumnSlice{
+					{Name: "campaign_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+					{Name: "status", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				},
+			},
+			campaignID: "campaign_id",
+		},
+		{
+			name:   "api_native",
+			config: salesforceConfig{NamingConvention: strPtr("api_native")},
+			dm: dynamicMap{
+				cols: []*plugin.Column{{Name: "CampaignId"}, {Name: "Status"}},
+				keyColumns: plugin.KeyColumnSlice{
+					{Name: "CampaignId", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+					{Name: "Status", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				},
+			},
+			campaignID: "CampaignId",
+		},
+		{
+			name:       "not described",
+			config:     salesforceConfig{},
+			dm:         dynamicMap{},
+			campaignID: "campaign_id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyColumns := SalesforceCampaignMember(ctx, tt.dm, tt.config).List.KeyColumns
+			if expected := max(len(tt.dm.keyColumns), 1); len(keyColumns) != expected {
+				t.Errorf("len(keyColumns) = %d, want %d", len(keyColumns), expected)
+			}
+			campaignID := keyColumn(keyColumns, tt.campaignID)
+			if campaignID == nil || campaignID.Require != plugin.Required || !slices.Equal(campaignID.Operators, []string{"="}) {
+				t.Fatalf("%s key column = %v, want required with =", tt.campaignID, campaignID)
+			}
+			for _, other := range tt.dm.keyColumns {
+				if other.Name != tt.campaignID && keyColumn(keyColumns, other.Name) != other {
+					t.Errorf("%s key column missing or changed", other.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestQuoteLineItemKeyColumns(t *testing.T) {
+	ctx := context.Background()
+	dm := dynamicMap{
+		cols: []*plugin.Column{{Name: "quote_id"}, {Name: "quantity"}},
+		keyColumns: plugin.KeyColumnSlice{
+			{Name: "quote_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+			{Name: "quantity", Require: plugin.Optional, Operators: []string{"=", "<>", ">", ">=", "<", "<="}},
+		},
+	}
+	for _, table := range []*plugin.Table{SalesforceQuoteLineItem(ctx, dm, salesforceConfig{}), SalesforceQuoteLineItem(ctx, dynamicMap{}, salesforceConfig{})} {
+		var quoteID *plugin.KeyColumn
+		for _, keyColumn := range table.List.KeyColumns {
+			if keyColumn.Name == "quote_id" {
+				quoteID = keyColumn
+			} else if keyColumn.Require != plugin.Optional {
+				t.Errorf("%s key column should stay optional", keyColumn.Name)
+			}
+		}
+		if quoteID == nil || quoteID.Require != plugin.Required || !slices.Equal(quoteID.Operators, []string{"="}) {
+			t.Errorf("quote_id key column = %v, want required with =", quoteID)
+		}
+		if table.Get == nil || len(table.Get.KeyColumns) != 1 || table.Get.KeyColumns[0].Name != "id" {
+			t.Error("line items should be readable by id without a quote_id")
+		}
+	}
+
+	// Quotes can be listed without any filter
+	for _, keyColumn := range SalesforceQuote(ctx, dm, salesforceConfig{}).List.KeyColumns {
+		if keyColumn.Require != plugin.Optional {
+			t.Errorf("salesforce_quote %s key column = %v, want optional", keyColumn.Name, keyColumn.Require)
+		}
+	}
+}
+
+func TestOrderItemKeyColumns(t *testing.T) {
+	ctx := context.Background()
+	dm := dynamicMap{
+		cols: []*plugin.Column{{Name: "order_id"}, {Name: "quantity"}},
+		keyColumns: plugin.KeyColumnSlice{
+			{Name: "order_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+			{Name: "quantity", Require: plugin.Optional, Operators: []string{"=", "<>", ">", ">=", "<", "<="}},
+		},
+	}
+	for _, table := range []*plugin.Table{SalesforceOrderItem(ctx, dm, salesforceConfig{}), SalesforceOrderItem(ctx, dynamicMap{}, salesforceConfig{})} {
+		var orderID *plugin.KeyColumn
+		for _, keyColumn := range table.List.KeyColumns {
+			if keyColumn.Name == "order_id" {
+				orderID = keyColumn
+			} else if keyColumn.Require != plugin.Optional {
+				t.Errorf("%s key column should stay optional", keyColumn.Name)
+			}
+		}
+		if orderID == nil || orderID.Require != plugin.Required || !slices.Equal(orderID.Operators, []string{"="}) {
+			t.Errorf("order_id key column = %v, want required with =", orderID)
+		}
+		if table.Get == nil || len(table.Get.KeyColumns) != 1 || table.Get.KeyColumns[0].Name != "id" {
+			t.Error("order items should be readable by id without an order_id")
+		}
+	}
+
+	// Orders can be listed without any filter, and keep their date key columns
+	orderDM := dynamicMap{
+		cols: []*plugin.Column{{Name: "effective_date"}, {Name: "status"}},
+		keyColumns: plugin.KeyColumnSlice{
+			{Name: "effective_date", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}},
+			{Name: "status", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+		},
+	}
+	keyColumns := SalesforceOrder(ctx, orderDM, salesforceConfig{}).List.KeyColumns
+	if !slices.Equal(keyColumns, orderDM.keyColumns) {
+		t.Errorf("salesforce_order key columns = %v, want %v", keyColumns, orderDM.keyColumns)
+	}
+}
+
+func TestOpportunityHistoryTables(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := contextWithLogger(&buf)
+	tests := []struct {
+		object   string
+		table    func(context.Context, dynamicMap, salesforceConfig) *plugin.Table
+		fields   string
+		record   map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			object: "OpportunityHistory",
+			table:  SalesforceOpportunityHistory,
+			fields: `[
+				{"name":"Id","type":"id","soapType":"tns:ID"},
+				{"name":"OpportunityId","type":"reference","soapType":"tns:ID"},
+				{"name":"StageName","type":"picklist","soapType":"xsd:string"},
+				{"name":"Amount","type":"currency","soapType":"xsd:double"},
+				{"name":"Probability","type":"percent","soapType":"xsd:double"},
+				{"name":"CreatedDate","type":"datetime","soapType":"xsd:dateTime"}
+			]`,
+			record:   map[string]interface{}{"Id": "008xx01", "OpportunityId": "006xx01", "StageName": "Closed Won", "Amount": 5000.0, "Probability": 100.0, "CreatedDate": "2024-03-01T10:00:00.000+0000"},
+			expected: map[string]interface{}{"opportunity_id": "006xx01", "stage_name": "Closed Won", "amount": 5000.0, "probability": 100.0, "created_date": "2024-03-01T10:00:00.000+0000"},
+		},
+		{
+			object: "OpportunityFieldHistory",
+			table:  SalesforceOpportunityFieldHistory,
+			fields: `[
+				{"name":"Id","type":"id","soapType":"tns:ID"},
+				{"name":"OpportunityId","type":"reference","soapType":"tns:ID"},
+				{"name":"Field","type":"picklist","soapType":"xsd:string"},
+				{"name":"OldValue","type":"anyType","soapType":"xsd:anyType"},
+				{"name":"NewValue","type":"anyType","soapType":"xsd:anyType"},
+				{"name":"CreatedDate","type":"datetime","soapType":"xsd:dateTime"}
+			]`,
+			record:   map[string]interface{}{"Id": "017xx01", "OpportunityId": "006xx01", "Field": "StageName", "OldValue": "Prospecting", "NewValue": "Closed Won", "CreatedDate": "2024-03-01T10:00:00.000+0000"},
+			expected: map[string]interface{}{"opportunity_id": "006xx01", "field": "StageName", "old_value": "Prospecting", "new_value": "Closed Won", "created_date": "2024-03-01T10:00:00.000+0000"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.object, func(t *testing.T) {
+			config := salesforceConfig{}
+			cols, keyColumns, salesforceCols, readableFields := dynamicColumns(ctx, nil, newDescribeClient(t, tt.fields), tt.object, config)
+			table := tt.table(ctx, dynamicMap{cols, keyColumns, salesforceCols, readableFields}, config)
+
+			// The time range of the history is pushed down
+			var createdDate *plugin.KeyColumn
+			for _, keyColumn := range table.List.KeyColumns {
+				if keyColumn.Name == "created_date" {
+					createdDate = keyColumn
+				}
+			}
+			for _, operator := range []string{">", ">=", "<", "<="} {
+				if createdDate == nil || !slices.Contains(createdDate.Operators, operator) {
+					t.Errorf("created_date key column = %v, want it to support %s", createdDate, operator)
+				}
+			}
+
+			for name, want := range tt.expected {
+				i := slices.IndexFunc(table.Columns, func(col *plugin.Column) bool { return col.Name == name })
+				if i < 0 {
+					t.Errorf("missing column %q", name)
+					continue
+				}
+				if table.Columns[i].Transform != nil {
+					t.Errorf("%s should use the default transform", name)
+					continue
+				}
+				got, err := getFieldFromSObjectMapByColumnName(ctx, &transform.TransformData{ColumnName: name, HydrateItem: tt.record})
+				if err != nil || got != want {
+					t.Errorf("%s = %v (%v), want %v", name, got, err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestEmptyConfigWarning(t *testing.T) {
+	objects := []string{"CustomApp__c"}
+	tests := []struct {
+		name     string
+		config   salesforceConfig
+		contains []string
+	}{
+		{"empty config", salesforceConfig{}, []string{"no credentials and no objects", "access_token", "objects ="}},
+		{"empty objects", salesforceConfig{Objects: &[]string{}}, []string{"no credentials and no objects"}},
+		{"objects without credentials", salesforceConfig{Objects: &objects}, []string{"tables of the objects in objects can't be defined", "access_token"}},
+		{"url without credentials", salesforceConfig{URL: stringPtr("https://acme.my.salesforce.com")}, []string{"no credentials and no objects"}},
+		{"credentials without objects", salesforceConfig{URL: stringPtr("https://acme.my.salesforce.com"), AccessToken: stringPtr("token")}, nil},
+		{"credentials and objects", salesforceConfig{Username: stringPtr("user"), Password: stringPtr("password"), Objects: &objects}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := emptyConfigWarning(tt.config)
+			if len(tt.contains) == 0 && got != "" {
+				t.Errorf("emptyConfigWarning() = %q, want none", got)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(got, s) {
+					t.Errorf("emptyConfigWarning() = %q, want it to contain %q", got, s)
+				}
+			}
+		})
+	}
+
+	t.Run("logged when defining tables", func(t *testing.T) {
+		var buf bytes.Buffer
+		tables, err := pluginTableDefinitions(contextWithLogger(&buf), &plugin.TableMapData{Connection: &plugin.Connection{Config: salesforceConfig{}}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := tables["salesforce_account"]; !ok {
+			t.Error("built-in tables should still be defined")
+		}
+		if log := buf.String(); !strings.Contains(log, "[WARN]") || !strings.Contains(log, "no credentials and no objects are configured") {
+			t.Errorf("log = %q, want the empty config warning", log)
+		}
+	})
+}
+
+func TestObjectTableName(t *testing.T) {
+	skip := OBJECT_NAME_COLLISION_SKIP
+	taken := func(names ...string) func(string) bool {
+		return func(tableName string) bool { return slices.Contains(names, tableName) }
+	}
+
+	tests := []struct {
+		name       string
+		config     salesforceConfig
+		objectName string
+		taken      func(string) bool
+		expected   string
+		ok         bool
+	}{
+		{"custom object", salesforceConfig{}, "Widget__c", taken(), "salesforce_widget__c", true},
+		{"namespaced custom object", salesforceConfig{}, "SBQQ__Quote__c", taken(), "salesforce_sbqq__quote__c", true},
+		{"static table", salesforceConfig{}, "Account", taken("salesforce_account"), "", false},
+		{"static table in another case", salesforceConfig{}, "account", taken("salesforce_account"), "", false},
+		{"non-object table", salesforceConfig{}, "GroupMember", taken("salesforce_group_member"), "salesforce_group_member_object", true},
+		{"digits only differ", salesforceConfig{}, "Survey2__c", taken("salesforce_survey__c"), "salesforce_survey__c_object", true},
+		{"renamed table taken too", salesforceConfig{}, "Limits", taken("salesforce_limits", "salesforce_limits_object"), "", false},
+		{"skip", salesforceConfig{ObjectNameCollision: &skip}, "GroupMember", taken("salesforce_group_member"), "", false},
+		{"api_native", salesforceConfig{NamingConvention: strPtr("api_native")}, "Limits", taken("salesforce_limits"), "Limits", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := objectTableName(tt.config, tt.objectName, tt.taken)
+			if got != tt.expected || ok != tt.ok {
+				t.Errorf("objectTableName(%q) = %q, %v, want %q, %v", tt.objectName, got, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
+
+func TestSystemModstampPushdown(t *testing.T) {
+	server := newDescribeServer(t, `[
+		{"name":"Id","label":"Record ID","soapType":"tns:ID"},
+		{"name":"SystemModstamp","label":"System Modstamp","soapType":"xsd:dateTime"}
+	]`)
+
+	// Tables that aren't backed by a single Salesforce object don't use describe
+	nonObjectTables := map[string]bool{
+		"salesforce_apex_class":                true,
+		"salesforce_apex_trigger":              true,
+		"salesforce_connection":                true,
+		"salesforce_field":                     true,
+		"salesforce_field_permission":          true,
+		"salesforce_flow":                      true,
+		"salesforce_flow_version":              true,
+		"salesforce_group_member":              true,
+		"salesforce_limits":                    true,
+		"salesforce_object_relationship":       true,
+		"salesforce_permission_set_group":      true,
+		"salesforce_picklist_value":            true,
+		"salesforce_product_category":          true,
+		"salesforce_product_category_product":  true,
+		"salesforce_query":                     true,
+		"salesforce_record_count":              true,
+		"salesforce_report_subscription":       true,
+		"salesforce_sobject":                   true,
+		"salesforce_storage_usage":             true,
+		"salesforce_territory_assignment_rule": true,
+		"salesforce_tooling_query":             true,
+	}
+
+	for _, namingConvention := range []string{"snake_case", "api_native"} {
+		t.Run(namingConvention, func(t *testing.T) {
+			columnName := "system_modstamp"
+			if namingConvention == "api_native" {
+				columnName = "SystemModstamp"
+			}
+			objects := []string{"Widget__c"}
+			config := salesforceConfig{
+				URL:              stringPtr(server.URL),
+				AccessToken:      stringPtr("sid"),
+				NamingConvention: strPtr(namingConvention),
+				Objects:          &objects,
+			}
+			var buf bytes.Buffer
+			tables, err := pluginTableDefinitions(contextWithLogger(&buf), &plugin.TableMapData{Connection: &plugin.Connection{Config: config}})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for name, table := range tables {
+				if nonObjectTables[name] {
+					continue
+				}
+				var column *plugin.Column
+				for _, c := range table.Columns {
+					if c.Name == columnName {
+						column = c
+					}
+				}
+				if column == nil {
+					t.Errorf("%s: missing %s column", name, columnName)
+					continue
+				}
+				if column.Type != proto.ColumnType_TIMESTAMP {
+					t.Errorf("%s: %s type = %v, want TIMESTAMP", name, columnName, column.Type)
+				}
+				var keyColumn *plugin.KeyColumn
+				for _, k := range table.List.KeyColumns {
+					if k.Name == columnName {
+						keyColumn = k
+					}
+				}
+				if keyColumn == nil || !slices.Contains(keyColumn.Operators, ">=") || !slices.Contains(keyColumn.Operators, "<") {
+					t.Errorf("%s: %s is not a range key column: %+v", name, columnName, keyColumn)
+				}
+
+				// The qual is pushed down to SOQL as a dateTime comparison
+				ts := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+				qualMap := makeQualMap(columnName, ">=", &proto.QualValue{
+					Value: &proto.QualValue_TimestampValue{TimestampValue: timestamppb.New(ts)},
+				})
+				got := buildQueryFromQuals(qualMap, table.Columns, map[string]string{columnName: "dateTime"}, salesforceConfig{})
+				if got != "SystemModstamp >= 2024-03-01T12:00:00Z" {
+					t.Errorf("%s: filter = %q, want SystemModstamp >= 2024-03-01T12:00:00Z", name, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceAccountContactRelation(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "AccountContactRelation"
+	return &plugin.Table{
+		Name:        "salesforce_account_contact_relation",
+		Description: "Represents a relationship between a contact and an account, either the contact's primary account or another account the contact works with.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the account contact relation in Salesforce."},
+			{Name: "account_id", Type: proto.ColumnType_STRING, Description: "ID of the account the contact is related to."},
+			{Name: "contact_id", Type: proto.ColumnType_STRING, Description: "ID of the contact related to the account."},
+			{Name: "roles", Type: proto.ColumnType_STRING, Description: "The roles of the contact at the account, separated by semicolons, for example Decision Maker;Influencer."},
+			{Name: "is_direct", Type: proto.ColumnType_BOOL, Description: "True if the account is the contact's primary account, false if the contact is indirectly related to it."},
+
+			// Other columns
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the relation."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the relation was created."},
+			{Name: "end_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the contact stopped working with the account."},
+			{Name: "is_active", Type: proto.ColumnType_BOOL, Description: "True if the contact currently works with the account."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "True if the relation has been moved to the Recycle Bin."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who last modified the relation."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the relation was last modified."},
+			{Name: "start_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the contact started working with the account."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the record was last modified by a user or by an automated process."},
+		}),
+	}
+}
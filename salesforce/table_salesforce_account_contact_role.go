@@ -20,7 +20,7 @@ func SalesforceAccountContactRole(ctx context.Context, dm dynamicMap, config sal
 			Hydrate:    getSalesforceObjectbyID(tableName),
 			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
 		},
-		Columns: mergeTableColumns(ctx, config, dm.cols, []*plugin.Column{
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
 			// Top columns
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the account contact role in Salesforce."},
 			{Name: "account_id", Type: proto.ColumnType_STRING, Description: "ID of the Account."},
@@ -0,0 +1,154 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The Body field isn't selected, since it can be large, so listing classes
+// stays cheap. It is read per class by getApexBody, only when requested.
+const apexClassQuery = "SELECT Id, Name, ApiVersion, Status, IsValid, LengthWithoutComments, NamespacePrefix, CreatedDate, LastModifiedDate FROM ApexClass"
+
+type apexClassRow struct {
+	ID                    string
+	Name                  string
+	APIVersion            *float64
+	Status                string
+	IsValid               bool
+	LengthWithoutComments *int64
+	NamespacePrefix       string
+	CreatedDate           string
+	LastModifiedDate      string
+}
+
+func SalesforceApexClass(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_apex_class",
+		Description: "Represents an Apex class, read with the Tooling API.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceApexClasses,
+			KeyColumns: plugin.OptionalColumns([]string{"name", "status"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the class.", Transform: transform.FromField("ID")},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the class.", Transform: transform.FromField("Name")},
+			{Name: "api_version", Type: proto.ColumnType_DOUBLE, Description: "The API version the class is saved with, e.g. 62.", Transform: transform.FromField("APIVersion")},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the class: Active, Deleted or Inactive.", Transform: transform.FromField("Status")},
+			{Name: "is_valid", Type: proto.ColumnType_BOOL, Description: "Indicates whether the class and its dependencies compile without changes.", Transform: transform.FromField("IsValid")},
+			{Name: "length_without_comments", Type: proto.ColumnType_INT, Description: "The number of characters of the class, excluding comments, which counts towards the Apex code size limit of the organization.", Transform: transform.FromField("LengthWithoutComments")},
+			{Name: "namespace_prefix", Type: proto.ColumnType_STRING, Description: "The namespace prefix of the managed package the class was installed from, or null.", Transform: transform.FromField("NamespacePrefix").NullIfZero()},
+			{Name: "body", Type: proto.ColumnType_STRING, Description: "The source code of the class. It is read with one request per class, and is \"(hidden)\" for classes of managed packages.", Hydrate: getApexBody("ApexClass"), Transform: transform.FromValue()},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the class was created.", Transform: transform.FromField("CreatedDate").NullIfZero()},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the class was last modified.", Transform: transform.FromField("LastModifiedDate").NullIfZero()},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceApexClasses(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_apex_class.listSalesforceApexClasses", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_apex_class.listSalesforceApexClasses: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	query := apexClassQuery
+	if condition := buildApexCondition(d.EqualsQualString("name"), d.EqualsQualString("status")); condition != "" {
+		query = fmt.Sprintf("%s WHERE %s", query, condition)
+	}
+
+	_, err = streamToolingQueryRecords(ctx, d, client, "ApexClass", query, func(record map[string]interface{}) bool {
+		d.StreamListItem(ctx, mapApexClassRecord(record))
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_apex_class.listSalesforceApexClasses", "query error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getApexBody returns a hydrate function reading the Body field of the
+// ApexClass or ApexTrigger row with the Tooling API.
+func getApexBody(objectName string) func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+		var id string
+		switch row := h.Item.(type) {
+		case apexClassRow:
+			id = row.ID
+		case apexTriggerRow:
+			id = row.ID
+		}
+		if id == "" {
+			return nil, nil
+		}
+
+		client, err := connect(ctx, d)
+		if err != nil {
+			plugin.Logger(ctx).Error("salesforce.getApexBody", "connection error", err)
+			return nil, err
+		}
+		if client == nil {
+			return nil, fmt.Errorf("salesforce.getApexBody: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+		}
+
+		query := fmt.Sprintf("SELECT Body FROM %s WHERE Id = '%s'", objectName, escapeSOQLString(id))
+		_, result, err := toolingQueryWithRetry(ctx, d, client, objectName, query)
+		if err != nil {
+			plugin.Logger(ctx).Error("salesforce.getApexBody", "query error", err, "object", objectName, "id", id)
+			return nil, err
+		}
+		if len(result.Records) == 0 {
+			return nil, nil
+		}
+		body, _ := result.Records[0]["Body"].(string)
+		return body, nil
+	}
+}
+
+// buildApexCondition returns the SOQL WHERE condition for the optional name
+// and status quals of the Apex tables.
+func buildApexCondition(name, status string) string {
+	filters := []string{}
+	if name != "" {
+		filters = append(filters, fmt.Sprintf("Name = '%s'", escapeSOQLString(name)))
+	}
+	if status != "" {
+		filters = append(filters, fmt.Sprintf("Status = '%s'", escapeSOQLString(status)))
+	}
+	return strings.Join(filters, " AND ")
+}
+
+// mapApexClassRecord converts an ApexClass record into an apexClassRow.
+func mapApexClassRecord(record map[string]interface{}) apexClassRow {
+	row := apexClassRow{}
+	row.ID, _ = record["Id"].(string)
+	row.Name, _ = record["Name"].(string)
+	row.Status, _ = record["Status"].(string)
+	row.IsValid, _ = record["IsValid"].(bool)
+	row.NamespacePrefix, _ = record["NamespacePrefix"].(string)
+	row.CreatedDate, _ = record["CreatedDate"].(string)
+	row.LastModifiedDate, _ = record["LastModifiedDate"].(string)
+	if apiVersion, ok := record["ApiVersion"].(float64); ok {
+		row.APIVersion = &apiVersion
+	}
+	if length, ok := record["LengthWithoutComments"].(float64); ok {
+		value := int64(length)
+		row.LengthWithoutComments = &value
+	}
+	return row
+}
@@ -0,0 +1,95 @@
+package salesforce
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func TestMapApexClassRecord(t *testing.T) {
+	t.Run("converts numbers", func(t *testing.T) {
+		got := mapApexClassRecord(map[string]interface{}{
+			"attributes":            map[string]interface{}{"type": "ApexClass"},
+			"Id":                    "01pxx0000000001",
+			"Name":                  "AccountService",
+			"ApiVersion":            float64(45),
+			"Status":                "Active",
+			"IsValid":               true,
+			"LengthWithoutComments": float64(1834),
+			"CreatedDate":           "2024-02-01T10:00:00.000+0000",
+		})
+		if got.ID != "01pxx0000000001" || got.Name != "AccountService" || got.Status != "Active" || !got.IsValid {
+			t.Errorf("got %+v", got)
+		}
+		if got.APIVersion == nil || *got.APIVersion != 45 || got.LengthWithoutComments == nil || *got.LengthWithoutComments != 1834 {
+			t.Errorf("api version = %v, length = %v, want 45 and 1834", got.APIVersion, got.LengthWithoutComments)
+		}
+	})
+
+	t.Run("null fields", func(t *testing.T) {
+		got := mapApexClassRecord(map[string]interface{}{"Id": "01pxx0000000001", "NamespacePrefix": nil, "LengthWithoutComments": nil})
+		if got.NamespacePrefix != "" || got.LengthWithoutComments != nil || got.APIVersion != nil {
+			t.Errorf("got %+v, want only ID set", got)
+		}
+	})
+}
+
+func TestBuildApexCondition(t *testing.T) {
+	tests := []struct {
+		name       string
+		nameQual   string
+		statusQual string
+		expected   string
+	}{
+		{"no quals", "", "", ""},
+		{"name only", "AccountService", "", "Name = 'AccountService'"},
+		{"both quals", "AccountService", "Active", "Name = 'AccountService' AND Status = 'Active'"},
+		{"quote is escaped", "", "Active' OR Name != '", `Status = 'Active\' OR Name != \''`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildApexCondition(tt.nameQual, tt.statusQual)
+			if got != tt.expected {
+				t.Errorf("buildApexCondition() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetApexBody(t *testing.T) {
+	queries := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/services/data/v"+defaultAPIVersion+"/tooling/query" {
+			http.NotFound(w, r)
+			return
+		}
+		queries = append(queries, r.URL.Query().Get("q"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"totalSize":1,"done":true,"records":[{"attributes":{"type":"ApexTrigger"},"Body":"trigger AccountTrigger on Account (before insert) {}"}]}`)
+	}))
+	defer server.Close()
+	d := &plugin.QueryData{Connection: &plugin.Connection{Config: salesforceConfig{URL: stringPtr(server.URL), AccessToken: stringPtr("token")}}}
+
+	var buf bytes.Buffer
+	body, err := getApexBody("ApexTrigger")(contextWithLogger(&buf), d, &plugin.HydrateData{Item: apexTriggerRow{ID: "01qxx0000000001"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body != "trigger AccountTrigger on Account (before insert) {}" {
+		t.Errorf("body = %v", body)
+	}
+	if expected := "SELECT Body FROM ApexTrigger WHERE Id = '01qxx0000000001'"; len(queries) != 1 || queries[0] != expected {
+		t.Errorf("queries = %q, want %q", queries, expected)
+	}
+
+	// Rows without an ID, which can't be read, make no request
+	body, err = getApexBody("ApexClass")(contextWithLogger(&buf), d, &plugin.HydrateData{Item: apexClassRow{}})
+	if body != nil || err != nil || len(queries) != 1 {
+		t.Errorf("body = %v, err = %v, queries = %d, want nothing read", body, err, len(queries))
+	}
+}
@@ -0,0 +1,123 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Like apexClassQuery, the Body field is read by getApexBody.
+const apexTriggerQuery = "SELECT Id, Name, TableEnumOrId, ApiVersion, Status, IsValid, LengthWithoutComments, NamespacePrefix, UsageBeforeInsert, UsageAfterInsert, UsageBeforeUpdate, UsageAfterUpdate, UsageBeforeDelete, UsageAfterDelete, UsageAfterUndelete, CreatedDate, LastModifiedDate FROM ApexTrigger"
+
+type apexTriggerRow struct {
+	ID                    string
+	Name                  string
+	TableEnumOrID         string
+	APIVersion            *float64
+	Status                string
+	IsValid               bool
+	LengthWithoutComments *int64
+	NamespacePrefix       string
+	UsageBeforeInsert     bool
+	UsageAfterInsert      bool
+	UsageBeforeUpdate     bool
+	UsageAfterUpdate      bool
+	UsageBeforeDelete     bool
+	UsageAfterDelete      bool
+	UsageAfterUndelete    bool
+	CreatedDate           string
+	LastModifiedDate      string
+}
+
+func SalesforceApexTrigger(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_apex_trigger",
+		Description: "Represents an Apex trigger, read with the Tooling API.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceApexTriggers,
+			KeyColumns: plugin.OptionalColumns([]string{"name", "status"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the trigger.", Transform: transform.FromField("ID")},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the trigger.", Transform: transform.FromField("Name")},
+			{Name: "table_enum_or_id", Type: proto.ColumnType_STRING, Description: "The object the trigger is on: the API name of a standard object, such as Account, or the ID of a custom object.", Transform: transform.FromField("TableEnumOrID")},
+			{Name: "api_version", Type: proto.ColumnType_DOUBLE, Description: "The API version the trigger is saved with, e.g. 62.", Transform: transform.FromField("APIVersion")},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the trigger: Active, Deleted or Inactive.", Transform: transform.FromField("Status")},
+			{Name: "is_valid", Type: proto.ColumnType_BOOL, Description: "Indicates whether the trigger and its dependencies compile without changes.", Transform: transform.FromField("IsValid")},
+			{Name: "length_without_comments", Type: proto.ColumnType_INT, Description: "The number of characters of the trigger, excluding comments, which counts towards the Apex code size limit of the organization.", Transform: transform.FromField("LengthWithoutComments")},
+			{Name: "namespace_prefix", Type: proto.ColumnType_STRING, Description: "The namespace prefix of the managed package the trigger was installed from, or null.", Transform: transform.FromField("NamespacePrefix").NullIfZero()},
+			{Name: "usage_before_insert", Type: proto.ColumnType_BOOL, Description: "Indicates whether the trigger runs before records are inserted.", Transform: transform.FromField("UsageBeforeInsert")},
+			{Name: "usage_after_insert", Type: proto.ColumnType_BOOL, Description: "Indicates whether the trigger runs after records are inserted.", Transform: transform.FromField("UsageAfterInsert")},
+			{Name: "usage_before_update", Type: proto.ColumnType_BOOL, Description: "Indicates whether the trigger runs before records are updated.", Transform: transform.FromField("UsageBeforeUpdate")},
+			{Name: "usage_after_update", Type: proto.ColumnType_BOOL, Description: "Indicates whether the trigger runs after records are updated.", Transform: transform.FromField("UsageAfterUpdate")},
+			{Name: "usage_before_delete", Type: proto.ColumnType_BOOL, Description: "Indicates whether the trigger runs before records are deleted.", Transform: transform.FromField("UsageBeforeDelete")},
+			{Name: "usage_after_delete", Type: proto.ColumnType_BOOL, Description: "Indicates whether the trigger runs after records are deleted.", Transform: transform.FromField("UsageAfterDelete")},
+			{Name: "usage_after_undelete", Type: proto.ColumnType_BOOL, Description: "Indicates whether the trigger runs after records are restored from the Recycle Bin.", Transform: transform.FromField("UsageAfterUndelete")},
+			{Name: "body", Type: proto.ColumnType_STRING, Description: "The source code of the trigger. It is read with one request per trigger, and is \"(hidden)\" for triggers of managed packages.", Hydrate: getApexBody("ApexTrigger"), Transform: transform.FromValue()},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the trigger was created.", Transform: transform.FromField("CreatedDate").NullIfZero()},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the trigger was last modified.", Transform: transform.FromField("LastModifiedDate").NullIfZero()},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceApexTriggers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_apex_trigger.listSalesforceApexTriggers", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_apex_trigger.listSalesforceApexTriggers: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	query := apexTriggerQuery
+	if condition := buildApexCondition(d.EqualsQualString("name"), d.EqualsQualString("status")); condition != "" {
+		query = fmt.Sprintf("%s WHERE %s", query, condition)
+	}
+
+	_, err = streamToolingQueryRecords(ctx, d, client, "ApexTrigger", query, func(record map[string]interface{}) bool {
+		d.StreamListItem(ctx, mapApexTriggerRecord(record))
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_apex_trigger.listSalesforceApexTriggers", "query error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// mapApexTriggerRecord converts an ApexTrigger record into an apexTriggerRow.
+func mapApexTriggerRecord(record map[string]interface{}) apexTriggerRow {
+	row := apexTriggerRow{}
+	row.ID, _ = record["Id"].(string)
+	row.Name, _ = record["Name"].(string)
+	row.TableEnumOrID, _ = record["TableEnumOrId"].(string)
+	row.Status, _ = record["Status"].(string)
+	row.IsValid, _ = record["IsValid"].(bool)
+	row.NamespacePrefix, _ = record["NamespacePrefix"].(string)
+	row.UsageBeforeInsert, _ = record["UsageBeforeInsert"].(bool)
+	row.UsageAfterInsert, _ = record["UsageAfterInsert"].(bool)
+	row.UsageBeforeUpdate, _ = record["UsageBeforeUpdate"].(bool)
+	row.UsageAfterUpdate, _ = record["UsageAfterUpdate"].(bool)
+	row.UsageBeforeDelete, _ = record["UsageBeforeDelete"].(bool)
+	row.UsageAfterDelete, _ = record["UsageAfterDelete"].(bool)
+	row.UsageAfterUndelete, _ = record["UsageAfterUndelete"].(bool)
+	row.CreatedDate, _ = record["CreatedDate"].(string)
+	row.LastModifiedDate, _ = record["LastModifiedDate"].(string)
+	if apiVersion, ok := record["ApiVersion"].(float64); ok {
+		row.APIVersion = &apiVersion
+	}
+	if length, ok := record["LengthWithoutComments"].(float64); ok {
+		value := int64(length)
+		row.LengthWithoutComments = &value
+	}
+	return row
+}
@@ -0,0 +1,29 @@
+package salesforce
+
+import (
+	"testing"
+)
+
+func TestMapApexTriggerRecord(t *testing.T) {
+	got := mapApexTriggerRecord(map[string]interface{}{
+		"attributes":            map[string]interface{}{"type": "ApexTrigger"},
+		"Id":                    "01qxx0000000001",
+		"Name":                  "AccountTrigger",
+		"TableEnumOrId":         "Account",
+		"ApiVersion":            float64(62),
+		"Status":                "Inactive",
+		"LengthWithoutComments": float64(412),
+		"UsageBeforeInsert":     true,
+		"UsageAfterUpdate":      true,
+		"UsageAfterUndelete":    false,
+	})
+	if got.ID != "01qxx0000000001" || got.Name != "AccountTrigger" || got.TableEnumOrID != "Account" || got.Status != "Inactive" {
+		t.Errorf("got %+v", got)
+	}
+	if !got.UsageBeforeInsert || !got.UsageAfterUpdate || got.UsageAfterInsert || got.UsageAfterUndelete {
+		t.Errorf("got %+v, want before insert and after update usage", got)
+	}
+	if got.APIVersion == nil || *got.APIVersion != 62 || got.LengthWithoutComments == nil || *got.LengthWithoutComments != 412 {
+		t.Errorf("api version = %v, length = %v, want 62 and 412", got.APIVersion, got.LengthWithoutComments)
+	}
+}
@@ -20,7 +20,7 @@ func SalesforceAsset(ctx context.Context, dm dynamicMap, config salesforceConfig
 			Hydrate:    getSalesforceObjectbyID(tableName),
 			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
 		},
-		Columns: mergeTableColumns(ctx, config, dm.cols, []*plugin.Column{
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
 			// Top columns
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the product in asset."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "Name of the asset."},
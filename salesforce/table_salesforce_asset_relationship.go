@@ -0,0 +1,42 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceAssetRelationship(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "AssetRelationship"
+	return &plugin.Table{
+		Name:        "salesforce_asset_relationship",
+		Description: "Represents a non-hierarchical relationship between two assets, such as an asset that replaced or upgraded another one.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the asset relationship in Salesforce."},
+			{Name: "asset_relationship_number", Type: proto.ColumnType_STRING, Description: "Automatically generated number that identifies the asset relationship."},
+			{Name: "asset_id", Type: proto.ColumnType_STRING, Description: "ID of the primary asset of the relationship, e.g. the asset that was replaced."},
+			{Name: "related_asset_id", Type: proto.ColumnType_STRING, Description: "ID of the asset related to the primary asset, e.g. its replacement."},
+			{Name: "relationship_type", Type: proto.ColumnType_STRING, Description: "Type of the relationship. The default picklist includes the following values: Replacement, Upgrade, Crossgrade."},
+			{Name: "from_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time when the relationship started."},
+			{Name: "to_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time when the relationship ended."},
+
+			// Other columns
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the asset relationship."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the creation of the asset relationship."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the asset relationship has been moved to the Recycle Bin (true) or not (false)."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who most recently changed the asset relationship."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the most recent change to the asset relationship."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the asset relationship was last modified by a user or by an automated process."},
+		}),
+	}
+}
@@ -0,0 +1,40 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceAssignedResource(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "AssignedResource"
+	return &plugin.Table{
+		Name:        "salesforce_assigned_resource",
+		Description: "Represents a service resource, such as a technician, assigned to a service appointment.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the assigned resource in Salesforce."},
+			{Name: "assigned_resource_number", Type: proto.ColumnType_STRING, Description: "Auto-generated number identifying the assignment."},
+			{Name: "service_appointment_id", Type: proto.ColumnType_STRING, Description: "ID of the service appointment the resource is assigned to."},
+			{Name: "service_resource_id", Type: proto.ColumnType_STRING, Description: "ID of the service resource assigned to the appointment."},
+
+			// Other columns
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the assignment."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the creation of the assignment."},
+			{Name: "estimated_travel_time", Type: proto.ColumnType_DOUBLE, Description: "Estimated number of minutes the resource needs to travel to the appointment."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the assignment has been moved to the Recycle Bin (true) or not (false)."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who most recently changed the assignment."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the most recent change to the assignment."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the assignment was last modified by a user or by an automated process."},
+		}),
+	}
+}
@@ -0,0 +1,41 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceAuthSession(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "AuthSession"
+	return &plugin.Table{
+		Name:        "salesforce_auth_session",
+		Description: "Represents an active user session in the org, such as a browser or API session.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the session in Salesforce."},
+			{Name: "users_id", Type: proto.ColumnType_STRING, Description: "ID of the user who owns the session."},
+			{Name: "login_type", Type: proto.ColumnType_STRING, Description: "Type of login that started the session, such as Application, SAML Sfdc Initiated SSO or Remote Access 2.0."},
+			{Name: "session_type", Type: proto.ColumnType_STRING, Description: "Type of the session, such as UI, API, Oauth2 or Visualforce."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the user logged in and the session started."},
+			{Name: "source_ip", Type: proto.ColumnType_STRING, Description: "IP address the user logged in from."},
+
+			// Other columns
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the session was last refreshed."},
+			{Name: "login_history_id", Type: proto.ColumnType_STRING, Description: "ID of the login history entry of the login that started the session."},
+			{Name: "num_seconds_valid", Type: proto.ColumnType_INT, Description: "Number of seconds the session stays valid after last_modified_date without activity."},
+			{Name: "parent_id", Type: proto.ColumnType_STRING, Description: "ID of the session this session was created from, if any."},
+			{Name: "session_security_level", Type: proto.ColumnType_STRING, Description: "Security level of the session, STANDARD or HIGH_ASSURANCE."},
+			{Name: "user_type", Type: proto.ColumnType_STRING, Description: "Type of the user who owns the session, such as Standard or Guest."},
+		}),
+	}
+}
@@ -0,0 +1,58 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceCampaign(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "Campaign"
+	return &plugin.Table{
+		Name:        "salesforce_campaign",
+		Description: "Represents and tracks a marketing campaign, such as a direct mail promotion, webinar, or trade show.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the campaign in Salesforce."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "Name of the campaign."},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "Type of campaign, such as Direct Mail or Referral Program."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "Status of the campaign, such as Planned, In Progress or Completed."},
+			{Name: "is_active", Type: proto.ColumnType_BOOL, Description: "Indicates whether the campaign is active (true) or not (false)."},
+			{Name: "budgeted_cost", Type: proto.ColumnType_DOUBLE, Description: "Amount of money budgeted for the campaign."},
+			{Name: "actual_cost", Type: proto.ColumnType_DOUBLE, Description: "Amount of money spent to run the campaign."},
+
+			// Other columns
+			{Name: "amount_all_opportunities", Type: proto.ColumnType_DOUBLE, Description: "Amount of all opportunities associated with the campaign, including closed/won opportunities."},
+			{Name: "amount_won_opportunities", Type: proto.ColumnType_DOUBLE, Description: "Amount of closed/won opportunities associated with the campaign."},
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the campaign."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The creation date and time of the campaign."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "Description of the campaign."},
+			{Name: "end_date", Type: proto.ColumnType_TIMESTAMP, Description: "Ending date for the campaign. Responses received after this date are still counted."},
+			{Name: "expected_response", Type: proto.ColumnType_DOUBLE, Description: "Percentage of responses expected from the targets of the campaign."},
+			{Name: "expected_revenue", Type: proto.ColumnType_DOUBLE, Description: "Amount of money expected to be generated from the campaign."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the campaign has been moved to the Recycle Bin (true) or not (false)."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who last modified the campaign."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time of the last modification of the campaign."},
+			{Name: "number_of_contacts", Type: proto.ColumnType_INT, Description: "Number of contacts associated with the campaign."},
+			{Name: "number_of_converted_leads", Type: proto.ColumnType_INT, Description: "Number of leads associated with the campaign that were converted."},
+			{Name: "number_of_leads", Type: proto.ColumnType_INT, Description: "Number of leads associated with the campaign."},
+			{Name: "number_of_opportunities", Type: proto.ColumnType_INT, Description: "Number of opportunities associated with the campaign."},
+			{Name: "number_of_responses", Type: proto.ColumnType_INT, Description: "Number of contacts and unconverted leads with a member status of Responded."},
+			{Name: "number_of_won_opportunities", Type: proto.ColumnType_INT, Description: "Number of closed/won opportunities associated with the campaign."},
+			{Name: "number_sent", Type: proto.ColumnType_DOUBLE, Description: "Number of individuals targeted by the campaign, for example the number of emails sent."},
+			{Name: "owner_id", Type: proto.ColumnType_STRING, Description: "ID of the user who owns the campaign."},
+			{Name: "parent_id", Type: proto.ColumnType_STRING, Description: "ID of the parent campaign, if the campaign is part of a campaign hierarchy."},
+			{Name: "start_date", Type: proto.ColumnType_TIMESTAMP, Description: "Starting date for the campaign."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the campaign was last modified by a user or by an automated process."},
+		}),
+	}
+}
@@ -0,0 +1,46 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceCampaignMember(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "CampaignMember"
+	return &plugin.Table{
+		Name:        "salesforce_campaign_member",
+		Description: "Represents the association between a campaign and either a lead or a contact.",
+		List: &plugin.ListConfig{
+			Hydrate: listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			// Campaigns can have millions of members, so members are listed one
+			// campaign at a time
+			KeyColumns: requireKeyColumn(dm.keyColumns, checkColumnNameScheme(config, dm.cols, "campaign_id")),
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the campaign member in Salesforce."},
+			{Name: "campaign_id", Type: proto.ColumnType_STRING, Description: "ID of the campaign."},
+			{Name: "lead_or_contact_id", Type: proto.ColumnType_STRING, Description: "ID of the lead or contact that is a member of the campaign."},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "Whether the member is a Lead or a Contact."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "Status of the member in the campaign, such as Sent or Responded."},
+			{Name: "has_responded", Type: proto.ColumnType_BOOL, Description: "Indicates whether the member has responded to the campaign (true) or not (false)."},
+
+			// Other columns
+			{Name: "contact_id", Type: proto.ColumnType_STRING, Description: "ID of the contact, if the member is a contact."},
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who added the member to the campaign."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the member was added to the campaign."},
+			{Name: "first_responded_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the member first responded to the campaign."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the campaign member has been moved to the Recycle Bin (true) or not (false)."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who last modified the campaign member."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time of the last modification of the campaign member."},
+			{Name: "lead_id", Type: proto.ColumnType_STRING, Description: "ID of the lead, if the member is a lead."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the campaign member was last modified by a user or by an automated process."},
+		}),
+	}
+}
@@ -0,0 +1,81 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const (
+	connectionStatusOK    = "ok"
+	connectionStatusError = "error"
+)
+
+type connectionRow struct {
+	Status           string
+	Error            string
+	AuthMethod       string
+	InstanceURL      string
+	OrganizationID   string
+	OrganizationName string
+	IsSandbox        bool
+}
+
+func SalesforceConnection(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_connection",
+		Description: "Checks the connection's configuration by authenticating and querying the org, returning a single row with the outcome.",
+		List: &plugin.ListConfig{
+			Hydrate: listSalesforceConnection,
+		},
+		Columns: []*plugin.Column{
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "Outcome of the check, ok or error.", Transform: transform.FromField("Status")},
+			{Name: "error", Type: proto.ColumnType_STRING, Description: "Why authenticating or querying the org failed, if it did.", Transform: transform.FromField("Error").NullIfZero()},
+			{Name: "auth_method", Type: proto.ColumnType_STRING, Description: "Authentication method used for the configured credentials: access_token, refresh_token, jwt or password.", Transform: transform.FromField("AuthMethod").NullIfZero()},
+			{Name: "instance_url", Type: proto.ColumnType_STRING, Description: "URL of the Salesforce instance the connection authenticated to.", Transform: transform.FromField("InstanceURL").NullIfZero()},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Transform: transform.FromField("OrganizationID").NullIfZero()},
+			{Name: "organization_name", Type: proto.ColumnType_STRING, Description: "Name of the organization.", Transform: transform.FromField("OrganizationName").NullIfZero()},
+			{Name: "is_sandbox", Type: proto.ColumnType_BOOL, Description: "Indicates whether the organization is a sandbox (true) or production (false).", Transform: transform.FromField("IsSandbox")},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+// listSalesforceConnection returns the outcome of the check as a row rather
+// than an error, so a misconfigured connection can be diagnosed with a query.
+func listSalesforceConnection(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	d.StreamListItem(ctx, checkConnection(ctx, d))
+	return nil, nil
+}
+
+// checkConnection authenticates with the connection's credentials and queries
+// the Organization object.
+func checkConnection(ctx context.Context, d *plugin.QueryData) connectionRow {
+	row := connectionRow{Status: connectionStatusError, AuthMethod: authMethod(GetConfig(d.Connection))}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Warn("salesforce_connection.checkConnection", "connection error", err)
+		row.Error = err.Error()
+		return row
+	}
+	row.InstanceURL = client.GetLoc()
+
+	_, result, err := queryWithRetry(ctx, d, client, "Organization", "SELECT Id, Name, IsSandbox FROM Organization LIMIT 1")
+	if err != nil {
+		plugin.Logger(ctx).Warn("salesforce_connection.checkConnection", "query error", err)
+		row.Error = err.Error()
+		return row
+	}
+	if len(result.Records) > 0 {
+		org := result.Records[0]
+		row.OrganizationID = org.ID()
+		row.OrganizationName = org.StringField("Name")
+		row.IsSandbox, _ = org["IsSandbox"].(bool)
+	}
+	row.Status = connectionStatusOK
+	return row
+}
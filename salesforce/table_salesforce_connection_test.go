@@ -0,0 +1,82 @@
+package salesforce
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func TestAuthMethod(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   salesforceConfig
+		expected string
+	}{
+		{"none", salesforceConfig{}, ""},
+		{"password", salesforceConfig{Username: stringPtr("user"), Password: stringPtr("pass")}, "password"},
+		{"username without password", salesforceConfig{Username: stringPtr("user")}, ""},
+		{"jwt", salesforceConfig{Username: stringPtr("user"), PrivateKeyFile: stringPtr("key.pem")}, "jwt"},
+		{"refresh token over jwt", salesforceConfig{RefreshToken: stringPtr("refresh"), PrivateKey: stringPtr("key")}, "refresh_token"},
+		{"access token over everything", salesforceConfig{URL: stringPtr("https://example.my.salesforce.com"), AccessToken: stringPtr("token"), RefreshToken: stringPtr("refresh"), Username: stringPtr("user"), Password: stringPtr("pass")}, "access_token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := authMethod(tt.config); got != tt.expected {
+				t.Errorf("authMethod() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckConnection(t *testing.T) {
+	var buf bytes.Buffer
+
+	t.Run("ok", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"totalSize":1,"done":true,"records":[{"attributes":{"type":"Organization"},"Id":"00D000000000001EAA","Name":"Acme","IsSandbox":true}]}`)
+		}))
+		t.Cleanup(server.Close)
+
+		d := &plugin.QueryData{Connection: &plugin.Connection{Config: salesforceConfig{URL: stringPtr(server.URL), AccessToken: stringPtr("token")}}}
+		row := checkConnection(contextWithLogger(&buf), d)
+		expected := connectionRow{
+			Status:           connectionStatusOK,
+			AuthMethod:       "access_token",
+			InstanceURL:      server.URL,
+			OrganizationID:   "00D000000000001EAA",
+			OrganizationName: "Acme",
+			IsSandbox:        true,
+		}
+		if row != expected {
+			t.Errorf("row = %+v, want %+v", row, expected)
+		}
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `[{"message":"The REST API is not enabled for this Organization.","errorCode":"API_DISABLED_FOR_ORG"}]`)
+		}))
+		t.Cleanup(server.Close)
+
+		d := &plugin.QueryData{Connection: &plugin.Connection{Config: salesforceConfig{URL: stringPtr(server.URL), AccessToken: stringPtr("token")}}}
+		row := checkConnection(contextWithLogger(&buf), d)
+		if row.Status != connectionStatusError || row.Error == "" || row.InstanceURL != server.URL || row.OrganizationID != "" {
+			t.Errorf("row = %+v, want an error row with the instance URL", row)
+		}
+	})
+
+	t.Run("no credentials", func(t *testing.T) {
+		d := &plugin.QueryData{Connection: &plugin.Connection{Config: salesforceConfig{}}}
+		row := checkConnection(contextWithLogger(&buf), d)
+		if row.Status != connectionStatusError || row.Error == "" || row.AuthMethod != "" || row.InstanceURL != "" {
+			t.Errorf("row = %+v, want an error row without an auth method", row)
+		}
+	})
+}
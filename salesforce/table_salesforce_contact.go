@@ -20,7 +20,7 @@ func SalesforceContact(ctx context.Context, dm dynamicMap, config salesforceConf
 			Hydrate:    getSalesforceObjectbyID(tableName),
 			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
 		},
-		Columns: mergeTableColumns(ctx, config, dm.cols, []*plugin.Column{
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
 			// Top columns
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "ID of the account that's the parent of this contact."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The full name of the contact."},
@@ -20,7 +20,7 @@ func SalesforceContract(ctx context.Context, dm dynamicMap, config salesforceCon
 			Hydrate:    getSalesforceObjectbyID(tableName),
 			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
 		},
-		Columns: mergeTableColumns(ctx, config, dm.cols, []*plugin.Column{
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
 			// Top columns
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the contract in Salesforce."},
 			{Name: "account_id", Type: proto.ColumnType_STRING, Description: "ID of the Account associated with this contract."},
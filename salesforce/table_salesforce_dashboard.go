@@ -0,0 +1,121 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func SalesforceDashboard(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "Dashboard"
+	return &plugin.Table{
+		Name:        "salesforce_dashboard",
+		Description: "Represents a dashboard, which shows data from source reports as visual components.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the dashboard in Salesforce."},
+			{Name: "title", Type: proto.ColumnType_STRING, Description: "Title of the dashboard."},
+			{Name: "developer_name", Type: proto.ColumnType_STRING, Description: "Unique name of the dashboard in the API."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Description: "ID of the folder that contains the dashboard."},
+			{Name: "folder_name", Type: proto.ColumnType_STRING, Description: "Name of the folder that contains the dashboard."},
+			{Name: "running_user_id", Type: proto.ColumnType_STRING, Description: "ID of the user whose access to data the dashboard's results are based on."},
+			{Name: "last_refresh_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time the dashboard's components were most recently refreshed. Null if the dashboard has never been refreshed or its status can't be read. Reading it costs an API call per dashboard.", Hydrate: getSalesforceDashboardLastRefreshDate, Transform: transform.FromValue()},
+
+			// Other columns
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the dashboard."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the creation of the dashboard."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "Description of the dashboard."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the dashboard has been moved to the Recycle Bin (true) or not (false)."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who most recently changed the dashboard."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the most recent change to the dashboard."},
+			{Name: "last_referenced_date", Type: proto.ColumnType_TIMESTAMP, Description: "The timestamp when the current user last accessed the dashboard, or a record related to it."},
+			{Name: "last_viewed_date", Type: proto.ColumnType_TIMESTAMP, Description: "The timestamp when the current user last viewed the dashboard."},
+			{Name: "namespace_prefix", Type: proto.ColumnType_STRING, Description: "Namespace prefix of the managed package the dashboard belongs to, if any."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the dashboard was last modified by a user or by an automated process."},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "Whose access to data the dashboard's results are based on: SpecifiedUser (running_user_id), LoggedInUser or MyTeamUser."},
+		}),
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+// getSalesforceDashboardLastRefreshDate reads when the dashboard's components
+// were last refreshed from the Analytics API. Dashboards whose status can't be
+// read, e.g. because the user can't run them, return null rather than failing
+// the query.
+func getSalesforceDashboardLastRefreshDate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	record, ok := h.Item.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	id, _ := record["Id"].(string)
+	if id == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_dashboard.getSalesforceDashboardLastRefreshDate", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_dashboard.getSalesforceDashboardLastRefreshDate: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	path := fmt.Sprintf("services/data/v%s/analytics/dashboards/%s/status", getAPIVersion(GetConfig(d.Connection)), id)
+	_, data, err := restGetWithRetry(ctx, d, client, path)
+	if err != nil {
+		plugin.Logger(ctx).Warn("salesforce_dashboard.getSalesforceDashboardLastRefreshDate", "dashboard_id", id, "status error", err)
+		return nil, nil
+	}
+
+	refreshDate, err := parseDashboardLastRefreshDate(data)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_dashboard.getSalesforceDashboardLastRefreshDate", "dashboard_id", id, "status decoding error", err)
+		return nil, err
+	}
+	return refreshDate, nil
+}
+
+// parseDashboardLastRefreshDate returns the most recent refresh date of the
+// components in an Analytics API dashboard status response, or nil if none
+// of them has been refreshed.
+// Ref: https://developer.salesforce.com/docs/atlas.en-us.api_analytics.meta/api_analytics/analytics_api_dashboard_status_resource.htm
+func parseDashboardLastRefreshDate(data []byte) (*time.Time, error) {
+	var status struct {
+		ComponentStatus []struct {
+			RefreshDate *string `json:"refreshDate"`
+		} `json:"componentStatus"`
+	}
+	if err := json.Unmarshal(data, &status); err != nil {
+		return nil, fmt.Errorf("failed to parse dashboard status: %v", err)
+	}
+
+	var latest *time.Time
+	for _, component := range status.ComponentStatus {
+		if component.RefreshDate == nil || *component.RefreshDate == "" {
+			continue
+		}
+		refreshDate, err := time.Parse("2006-01-02T15:04:05.000-0700", *component.RefreshDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse dashboard refresh date %q: %v", *component.RefreshDate, err)
+		}
+		if latest == nil || refreshDate.After(*latest) {
+			latest = &refreshDate
+		}
+	}
+	return latest, nil
+}
@@ -0,0 +1,60 @@
+package salesforce
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDashboardLastRefreshDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected *time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "latest component refresh",
+			data:     `{"componentStatus":[{"componentId":"01axx0000001","refreshDate":"2013-06-24T22:06:56.000+0000","refreshStatus":"IDLE"},{"componentId":"01axx0000002","refreshDate":"2013-06-25T09:30:00.000+0200","refreshStatus":"IDLE"}]}`,
+			expected: timePtr(time.Date(2013, 6, 25, 7, 30, 0, 0, time.UTC)),
+		},
+		{
+			name: "never refreshed",
+			data: `{"componentStatus":[{"componentId":"01axx0000001","refreshDate":null,"refreshStatus":"IDLE"}]}`,
+		},
+		{
+			name: "no components",
+			data: `{"componentStatus":[]}`,
+		},
+		{
+			name:    "invalid refresh date",
+			data:    `{"componentStatus":[{"componentId":"01axx0000001","refreshDate":"yesterday"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			data:    `<html>`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDashboardLastRefreshDate([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDashboardLastRefreshDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.expected == nil {
+				if got != nil {
+					t.Errorf("parseDashboardLastRefreshDate() = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil || !got.Equal(*tt.expected) {
+				t.Errorf("parseDashboardLastRefreshDate() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
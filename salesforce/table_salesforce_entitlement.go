@@ -0,0 +1,50 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceEntitlement(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "Entitlement"
+	return &plugin.Table{
+		Name:        "salesforce_entitlement",
+		Description: "Represents the customer support an account or asset is entitled to, such as phone support or a number of cases, for a period of time.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the entitlement in Salesforce."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "Name of the entitlement."},
+			{Name: "account_id", Type: proto.ColumnType_STRING, Description: "ID of the account that is entitled to support."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "Status of the entitlement, Active, Expired or Inactive, computed from its start and end dates."},
+			{Name: "start_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date on which the entitlement starts."},
+			{Name: "end_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date on which the entitlement ends."},
+
+			// Other columns
+			{Name: "asset_id", Type: proto.ColumnType_STRING, Description: "ID of the asset covered by the entitlement."},
+			{Name: "business_hours_id", Type: proto.ColumnType_STRING, Description: "ID of the business hours during which support is provided."},
+			{Name: "cases_per_entitlement", Type: proto.ColumnType_INT, Description: "Number of cases the entitlement covers, if it is per incident."},
+			{Name: "contract_line_item_id", Type: proto.ColumnType_STRING, Description: "ID of the contract line item the entitlement belongs to."},
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the entitlement."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the creation of the entitlement."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the entitlement has been moved to the Recycle Bin (true) or not (false)."},
+			{Name: "is_per_incident", Type: proto.ColumnType_BOOL, Description: "Indicates whether the entitlement covers a limited number of cases (true) or not (false)."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who most recently changed the entitlement."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the most recent change to the entitlement."},
+			{Name: "remaining_cases", Type: proto.ColumnType_INT, Description: "Number of cases the entitlement still covers, if it is per incident."},
+			{Name: "service_contract_id", Type: proto.ColumnType_STRING, Description: "ID of the service contract the entitlement belongs to."},
+			{Name: "sla_process_id", Type: proto.ColumnType_STRING, Description: "ID of the entitlement process, which sets the milestones of the entitlement's cases."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the entitlement was last modified by a user or by an automated process."},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "Type of the entitlement, such as Phone Support or Web Support."},
+		}),
+	}
+}
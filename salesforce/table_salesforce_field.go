@@ -0,0 +1,140 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type fieldRow struct {
+	SObject          string
+	Name             string
+	Label            string
+	Type             string
+	SoapType         string
+	Length           int
+	Precision        int
+	Scale            int
+	Nillable         bool
+	Custom           bool
+	Sortable         bool
+	Groupable        bool
+	Aggregatable     bool
+	ReferenceTo      []string
+	RelationshipName string
+	PicklistCount    int
+}
+
+func SalesforceField(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_field",
+		Description: "The fields of a Salesforce object, as returned by its describe.",
+		List: &plugin.ListConfig{
+			Hydrate: listSalesforceFields,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "sobject", Require: plugin.Required},
+				{Name: "name", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{Name: "sobject", Type: proto.ColumnType_STRING, Description: "The API name of the object, for example Account.", Transform: transform.FromField("SObject")},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The API name of the field, as used in SOQL.", Transform: transform.FromField("Name")},
+			{Name: "label", Type: proto.ColumnType_STRING, Description: "The label of the field displayed to users.", Transform: transform.FromField("Label")},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The Salesforce type of the field, for example string, picklist, reference or currency.", Transform: transform.FromField("Type")},
+			{Name: "soap_type", Type: proto.ColumnType_STRING, Description: "The SOAP type of the field, for example string, double or ID, which sets the type of its column.", Transform: transform.FromField("SoapType")},
+			{Name: "length", Type: proto.ColumnType_INT, Description: "The maximum number of characters of a text field, or 0 for other fields.", Transform: transform.FromField("Length")},
+			{Name: "precision", Type: proto.ColumnType_INT, Description: "The maximum number of digits of a number, currency or percent field, or 0 for other fields.", Transform: transform.FromField("Precision")},
+			{Name: "scale", Type: proto.ColumnType_INT, Description: "The number of digits after the decimal point of a number, currency or percent field, or 0 for other fields.", Transform: transform.FromField("Scale")},
+			{Name: "nillable", Type: proto.ColumnType_BOOL, Description: "True if the field can be null.", Transform: transform.FromField("Nillable")},
+			{Name: "custom", Type: proto.ColumnType_BOOL, Description: "True if the field is a custom field.", Transform: transform.FromField("Custom")},
+			{Name: "sortable", Type: proto.ColumnType_BOOL, Description: "True if the field can be used in ORDER BY.", Transform: transform.FromField("Sortable")},
+			{Name: "groupable", Type: proto.ColumnType_BOOL, Description: "True if the field can be used in GROUP BY.", Transform: transform.FromField("Groupable")},
+			{Name: "aggregatable", Type: proto.ColumnType_BOOL, Description: "True if the field can be used in aggregate functions, such as SUM.", Transform: transform.FromField("Aggregatable")},
+			{Name: "reference_to", Type: proto.ColumnType_JSON, Description: "The objects a lookup or master-detail field can refer to.", Transform: transform.FromField("ReferenceTo")},
+			{Name: "relationship_name", Type: proto.ColumnType_STRING, Description: "The name of the relationship of a lookup or master-detail field, as used in SOQL paths, for example Owner.", Transform: transform.FromField("RelationshipName")},
+			{Name: "picklist_count", Type: proto.ColumnType_INT, Description: "The number of values of a picklist field, including inactive values. See salesforce_picklist_value for the values.", Transform: transform.FromField("PicklistCount")},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceFields(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	objectName := d.EqualsQualString("sobject")
+	if objectName == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_field.listSalesforceFields", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_field.listSalesforceFields: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	sObjectMeta := describeSObject(ctx, d.ConnectionCache, client, GetConfig(d.Connection), objectName)
+	if sObjectMeta == nil {
+		// Like a filter on a missing record, an unknown object has no fields
+		plugin.Logger(ctx).Warn("salesforce_field.listSalesforceFields", "object_name", objectName, "msg", "object could not be described")
+		return nil, nil
+	}
+
+	rows, err := buildFieldRows(objectName, sObjectMeta, d.EqualsQualString("name"))
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_field.listSalesforceFields", "describe decoding error", err)
+		return nil, err
+	}
+	for _, row := range rows {
+		d.StreamListItem(ctx, row)
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// buildFieldRows returns a row per field of objectName, or only the field
+// called fieldName if it isn't empty, in the order of the describe. Unlike
+// object tables, fields excluded by object_fields are listed, since they are
+// still fields of the object.
+func buildFieldRows(objectName string, sObjectMeta *simpleforce.SObjectMeta, fieldName string) ([]fieldRow, error) {
+	fields, err := describeFields(sObjectMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []fieldRow{}
+	for _, field := range fields {
+		if fieldName != "" && field.Name != fieldName {
+			continue
+		}
+		rows = append(rows, fieldRow{
+			SObject:          objectName,
+			Name:             field.Name,
+			Label:            field.Label,
+			Type:             field.Type,
+			SoapType:         field.soapType(),
+			Length:           field.Length,
+			Precision:        field.Precision,
+			Scale:            field.Scale,
+			Nillable:         field.Nillable,
+			Custom:           field.Custom,
+			Sortable:         field.Sortable,
+			Groupable:        field.Groupable,
+			Aggregatable:     field.Aggregatable,
+			ReferenceTo:      field.ReferenceTo,
+			RelationshipName: field.RelationshipName,
+			PicklistCount:    len(field.PicklistValues),
+		})
+	}
+	return rows, nil
+}
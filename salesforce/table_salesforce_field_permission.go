@@ -0,0 +1,118 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const fieldPermissionQuery = "SELECT Id, ParentId, Parent.Name, Parent.Label, Parent.IsOwnedByProfile, Parent.Profile.Name, SobjectType, Field, PermissionsRead, PermissionsEdit FROM FieldPermissions"
+
+type fieldPermissionRow struct {
+	ID              string
+	ParentID        string
+	ParentName      string
+	ParentType      string
+	SobjectType     string
+	Field           string
+	PermissionsRead bool
+	PermissionsEdit bool
+}
+
+func SalesforceFieldPermission(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_field_permission",
+		Description: "Represents the enabled field permissions for a profile or permission set.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceFieldPermissions,
+			KeyColumns: plugin.OptionalColumns([]string{"parent_id", "sobject_type", "field"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The FieldPermissions ID.", Transform: transform.FromField("ID")},
+			{Name: "parent_id", Type: proto.ColumnType_STRING, Description: "The ID of the parent PermissionSet. Profiles are represented by the permission set they own.", Transform: transform.FromField("ParentID")},
+			{Name: "parent_name", Type: proto.ColumnType_STRING, Description: "The name of the profile, if the parent permission set is owned by a profile, otherwise the name of the permission set.", Transform: transform.FromField("ParentName")},
+			{Name: "parent_type", Type: proto.ColumnType_STRING, Description: "The kind of parent granting the permission: Profile or PermissionSet.", Transform: transform.FromField("ParentType")},
+			{Name: "sobject_type", Type: proto.ColumnType_STRING, Description: "The object's API name, for example Account.", Transform: transform.FromField("SobjectType")},
+			{Name: "field", Type: proto.ColumnType_STRING, Description: "The field's API name qualified by its object, for example Account.Rating.", Transform: transform.FromField("Field")},
+			{Name: "permissions_read", Type: proto.ColumnType_BOOL, Description: "If true, users assigned to the parent can read the field.", Transform: transform.FromField("PermissionsRead")},
+			{Name: "permissions_edit", Type: proto.ColumnType_BOOL, Description: "If true, users assigned to the parent can read and edit the field.", Transform: transform.FromField("PermissionsEdit")},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceFieldPermissions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_field_permission.listSalesforceFieldPermissions", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_field_permission.listSalesforceFieldPermissions: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	query := fieldPermissionQuery
+	if condition := buildFieldPermissionCondition(d.EqualsQualString("parent_id"), d.EqualsQualString("sobject_type"), d.EqualsQualString("field")); condition != "" {
+		query = fmt.Sprintf("%s WHERE %s", query, condition)
+	}
+
+	_, err = streamQueryRecords(ctx, d, client, "FieldPermissions", query, func(record map[string]interface{}) bool {
+		d.StreamListItem(ctx, mapFieldPermissionRecord(record))
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_field_permission.listSalesforceFieldPermissions", "query error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// buildFieldPermissionCondition returns the SOQL WHERE condition for the
+// optional parent_id, sobject_type and field quals.
+func buildFieldPermissionCondition(parentID, sobjectType, field string) string {
+	filters := []string{}
+	if parentID != "" {
+		filters = append(filters, fmt.Sprintf("ParentId = '%s'", escapeSOQLString(parentID)))
+	}
+	if sobjectType != "" {
+		filters = append(filters, fmt.Sprintf("SobjectType = '%s'", escapeSOQLString(sobjectType)))
+	}
+	if field != "" {
+		filters = append(filters, fmt.Sprintf("Field = '%s'", escapeSOQLString(field)))
+	}
+	return strings.Join(filters, " AND ")
+}
+
+// mapFieldPermissionRecord flattens a FieldPermissions record and its parent
+// PermissionSet into a fieldPermissionRow. Permission sets owned by a profile
+// are reported as the profile itself.
+func mapFieldPermissionRecord(record map[string]interface{}) fieldPermissionRow {
+	row := fieldPermissionRow{ParentType: "PermissionSet"}
+	row.ID, _ = record["Id"].(string)
+	row.ParentID, _ = record["ParentId"].(string)
+	row.SobjectType, _ = record["SobjectType"].(string)
+	row.Field, _ = record["Field"].(string)
+	row.PermissionsRead, _ = record["PermissionsRead"].(bool)
+	row.PermissionsEdit, _ = record["PermissionsEdit"].(bool)
+
+	parent, ok := record["Parent"].(map[string]interface{})
+	if !ok {
+		return row
+	}
+	row.ParentName, _ = parent["Name"].(string)
+	if isOwnedByProfile, _ := parent["IsOwnedByProfile"].(bool); isOwnedByProfile {
+		row.ParentType = "Profile"
+		if profile, ok := parent["Profile"].(map[string]interface{}); ok {
+			row.ParentName, _ = profile["Name"].(string)
+		}
+	}
+	return row
+}
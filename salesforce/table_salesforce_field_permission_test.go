@@ -0,0 +1,78 @@
+package salesforce
+
+import (
+	"testing"
+)
+
+func TestMapFieldPermissionRecord(t *testing.T) {
+	t.Run("permission set parent", func(t *testing.T) {
+		record := map[string]interface{}{
+			"Id":              "01kxx0000000001",
+			"ParentId":        "0PSxx0000000001",
+			"SobjectType":     "Account",
+			"Field":           "Account.Rating",
+			"PermissionsRead": true,
+			"PermissionsEdit": false,
+			"Parent": map[string]interface{}{
+				"Name":             "Sales_Ops",
+				"Label":            "Sales Ops",
+				"IsOwnedByProfile": false,
+				"Profile":          nil,
+			},
+		}
+		got := mapFieldPermissionRecord(record)
+		expected := fieldPermissionRow{
+			ID:              "01kxx0000000001",
+			ParentID:        "0PSxx0000000001",
+			ParentName:      "Sales_Ops",
+			ParentType:      "PermissionSet",
+			SobjectType:     "Account",
+			Field:           "Account.Rating",
+			PermissionsRead: true,
+		}
+		if got != expected {
+			t.Errorf("got %+v, want %+v", got, expected)
+		}
+	})
+
+	t.Run("profile-owned parent is reported as the profile", func(t *testing.T) {
+		record := map[string]interface{}{
+			"Id":              "01kxx0000000002",
+			"ParentId":        "0PSxx0000000002",
+			"SobjectType":     "Contact",
+			"Field":           "Contact.Email",
+			"PermissionsRead": true,
+			"PermissionsEdit": true,
+			"Parent": map[string]interface{}{
+				"Name":             "X00exx000000001",
+				"IsOwnedByProfile": true,
+				"Profile":          map[string]interface{}{"Name": "System Administrator"},
+			},
+		}
+		got := mapFieldPermissionRecord(record)
+		if got.ParentType != "Profile" || got.ParentName != "System Administrator" {
+			t.Errorf("parent = %q/%q, want Profile/System Administrator", got.ParentType, got.ParentName)
+		}
+		if !got.PermissionsRead || !got.PermissionsEdit {
+			t.Errorf("permissions = %v/%v, want true/true", got.PermissionsRead, got.PermissionsEdit)
+		}
+	})
+
+	t.Run("missing parent", func(t *testing.T) {
+		got := mapFieldPermissionRecord(map[string]interface{}{"Id": "01kxx0000000003"})
+		if got.ParentType != "PermissionSet" || got.ParentName != "" {
+			t.Errorf("got %+v, want default PermissionSet parent without name", got)
+		}
+	})
+}
+
+func TestBuildFieldPermissionCondition(t *testing.T) {
+	got := buildFieldPermissionCondition("0PSxx", "Account", "Account.Rating")
+	expected := "ParentId = '0PSxx' AND SobjectType = 'Account' AND Field = 'Account.Rating'"
+	if got != expected {
+		t.Errorf("got %q, want %q", got, expected)
+	}
+	if got := buildFieldPermissionCondition("", "", ""); got != "" {
+		t.Errorf("got %q, want empty string", got)
+	}
+}
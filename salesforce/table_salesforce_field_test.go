@@ -0,0 +1,70 @@
+package salesforce
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/simpleforce/simpleforce"
+)
+
+func TestBuildFieldRows(t *testing.T) {
+	var meta simpleforce.SObjectMeta
+	if err := json.Unmarshal([]byte(`{
+		"name": "Account",
+		"fields": [
+			{"name": "Id", "label": "Account ID", "type": "id", "soapType": "tns:ID", "length": 18, "nillable": false, "custom": false, "sortable": true, "groupable": true, "aggregatable": true, "referenceTo": [], "relationshipName": null, "picklistValues": []},
+			{"name": "OwnerId", "label": "Owner ID", "type": "reference", "soapType": "tns:ID", "length": 18, "nillable": false, "custom": false, "sortable": true, "groupable": true, "aggregatable": true, "referenceTo": ["User"], "relationshipName": "Owner", "picklistValues": []},
+			{"name": "Industry", "label": "Industry", "type": "picklist", "soapType": "xsd:string", "length": 255, "nillable": true, "custom": false, "sortable": true, "groupable": true, "aggregatable": true, "referenceTo": [], "relationshipName": null, "picklistValues": [
+				{"value": "Banking", "label": "Banking", "active": true, "defaultValue": false},
+				{"value": "Retail", "label": "Retail", "active": false, "defaultValue": false}
+			]},
+			{"name": "Score__c", "label": "Score", "type": "double", "soapType": "xsd:double", "length": 0, "precision": 5, "scale": 2, "nillable": true, "custom": true, "sortable": true, "groupable": false, "aggregatable": true, "referenceTo": [], "relationshipName": null, "picklistValues": []}
+		]
+	}`), &meta); err != nil {
+		t.Fatalf("invalid describe: %v", err)
+	}
+
+	t.Run("every field", func(t *testing.T) {
+		rows, err := buildFieldRows("Account", &meta, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		names := []string{}
+		for _, row := range rows {
+			names = append(names, row.Name)
+		}
+		if expected := []string{"Id", "OwnerId", "Industry", "Score__c"}; !slices.Equal(names, expected) {
+			t.Errorf("names = %v, want %v", names, expected)
+		}
+
+		owner := rows[1]
+		if owner.Type != "reference" || owner.SoapType != "ID" || owner.RelationshipName != "Owner" || !slices.Equal(owner.ReferenceTo, []string{"User"}) {
+			t.Errorf("rows[1] = %+v, want the Owner lookup to User", owner)
+		}
+		industry := rows[2]
+		if industry.SoapType != "string" || industry.Length != 255 || !industry.Nillable || industry.PicklistCount != 2 {
+			t.Errorf("rows[2] = %+v, want a nillable picklist with 2 values", industry)
+		}
+		score := rows[3]
+		if !score.Custom || score.Groupable || !score.Aggregatable || score.PicklistCount != 0 || score.Precision != 5 || score.Scale != 2 {
+			t.Errorf("rows[3] = %+v, want a custom aggregatable field that isn't groupable", score)
+		}
+	})
+
+	t.Run("filtered by name", func(t *testing.T) {
+		rows, err := buildFieldRows("account", &meta, "Score__c")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(rows) != 1 || rows[0].Name != "Score__c" || rows[0].SObject != "account" {
+			t.Errorf("rows = %+v, want only Score__c of account", rows)
+		}
+	})
+
+	t.Run("object not described", func(t *testing.T) {
+		if _, err := buildFieldRows("Widget", nil, ""); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
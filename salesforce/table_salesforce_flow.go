@@ -0,0 +1,135 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const flowQuery = "SELECT DurableId, ApiName, Label, Description, ProcessType, TriggerType, ActiveVersionId, LatestVersionId, IsActive, NamespacePrefix, LastModifiedDate FROM FlowDefinitionView"
+
+// Flows have an active version or none, so the status of a flow is one of
+// these.
+const (
+	flowStatusActive   = "Active"
+	flowStatusInactive = "Inactive"
+)
+
+type flowRow struct {
+	ID               string
+	APIName          string
+	Label            string
+	Description      string
+	ProcessType      string
+	TriggerType      string
+	ActiveVersionID  string
+	LatestVersionID  string
+	IsActive         bool
+	Status           string
+	NamespacePrefix  string
+	LastModifiedDate string
+}
+
+func SalesforceFlow(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_flow",
+		Description: "Represents a flow, such as a screen flow, a record-triggered flow or a legacy process, with its active and latest versions.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceFlows,
+			KeyColumns: plugin.OptionalColumns([]string{"api_name", "process_type", "trigger_type", "status"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the flow definition.", Transform: transform.FromField("ID")},
+			{Name: "api_name", Type: proto.ColumnType_STRING, Description: "The unique API name of the flow.", Transform: transform.FromField("APIName")},
+			{Name: "label", Type: proto.ColumnType_STRING, Description: "The label of the flow.", Transform: transform.FromField("Label")},
+			{Name: "process_type", Type: proto.ColumnType_STRING, Description: "The type of the flow, for example Flow for screen flows, AutoLaunchedFlow or Workflow for processes built with Process Builder.", Transform: transform.FromField("ProcessType")},
+			{Name: "trigger_type", Type: proto.ColumnType_STRING, Description: "What starts the flow, for example RecordAfterSave, RecordBeforeSave, Scheduled or PlatformEvent, or null if it is started by a user or another process.", Transform: transform.FromField("TriggerType").NullIfZero()},
+			{Name: "active_version_id", Type: proto.ColumnType_STRING, Description: "The ID of the active version of the flow, or null if no version is active.", Transform: transform.FromField("ActiveVersionID").NullIfZero()},
+			{Name: "latest_version_id", Type: proto.ColumnType_STRING, Description: "The ID of the most recent version of the flow.", Transform: transform.FromField("LatestVersionID").NullIfZero()},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "Active if a version of the flow is active, Inactive otherwise.", Transform: transform.FromField("Status")},
+			{Name: "is_active", Type: proto.ColumnType_BOOL, Description: "Indicates whether a version of the flow is active.", Transform: transform.FromField("IsActive")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the flow.", Transform: transform.FromField("Description")},
+			{Name: "namespace_prefix", Type: proto.ColumnType_STRING, Description: "The namespace prefix of the managed package the flow was installed from, or null.", Transform: transform.FromField("NamespacePrefix").NullIfZero()},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the flow was last modified.", Transform: transform.FromField("LastModifiedDate").NullIfZero()},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceFlows(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_flow.listSalesforceFlows", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_flow.listSalesforceFlows: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	query := flowQuery
+	if condition := buildFlowCondition(d.EqualsQualString("api_name"), d.EqualsQualString("process_type"), d.EqualsQualString("trigger_type"), d.EqualsQualString("status")); condition != "" {
+		query = fmt.Sprintf("%s WHERE %s", query, condition)
+	}
+
+	_, err = streamQueryRecords(ctx, d, client, "FlowDefinitionView", query, func(record map[string]interface{}) bool {
+		d.StreamListItem(ctx, mapFlowRecord(record))
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_flow.listSalesforceFlows", "query error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// buildFlowCondition returns the SOQL WHERE condition for the optional
+// api_name, process_type, trigger_type and status quals. The status is read
+// from IsActive; other statuses than Active and Inactive are left to
+// Postgres, which finds no rows for them.
+func buildFlowCondition(apiName, processType, triggerType, status string) string {
+	filters := []string{}
+	if apiName != "" {
+		filters = append(filters, fmt.Sprintf("ApiName = '%s'", escapeSOQLString(apiName)))
+	}
+	if processType != "" {
+		filters = append(filters, fmt.Sprintf("ProcessType = '%s'", escapeSOQLString(processType)))
+	}
+	if triggerType != "" {
+		filters = append(filters, fmt.Sprintf("TriggerType = '%s'", escapeSOQLString(triggerType)))
+	}
+	switch status {
+	case flowStatusActive:
+		filters = append(filters, "IsActive = true")
+	case flowStatusInactive:
+		filters = append(filters, "IsActive = false")
+	}
+	return strings.Join(filters, " AND ")
+}
+
+// mapFlowRecord converts a FlowDefinitionView record into a flowRow.
+func mapFlowRecord(record map[string]interface{}) flowRow {
+	row := flowRow{Status: flowStatusInactive}
+	row.ID, _ = record["DurableId"].(string)
+	row.APIName, _ = record["ApiName"].(string)
+	row.Label, _ = record["Label"].(string)
+	row.Description, _ = record["Description"].(string)
+	row.ProcessType, _ = record["ProcessType"].(string)
+	row.TriggerType, _ = record["TriggerType"].(string)
+	row.ActiveVersionID, _ = record["ActiveVersionId"].(string)
+	row.LatestVersionID, _ = record["LatestVersionId"].(string)
+	row.IsActive, _ = record["IsActive"].(bool)
+	row.NamespacePrefix, _ = record["NamespacePrefix"].(string)
+	row.LastModifiedDate, _ = record["LastModifiedDate"].(string)
+	if row.IsActive {
+		row.Status = flowStatusActive
+	}
+	return row
+}
@@ -0,0 +1,73 @@
+package salesforce
+
+import (
+	"testing"
+)
+
+func TestMapFlowRecord(t *testing.T) {
+	t.Run("active flow", func(t *testing.T) {
+		record := map[string]interface{}{
+			"attributes":       map[string]interface{}{"type": "FlowDefinitionView"},
+			"DurableId":        "300xx0000000001",
+			"ApiName":          "Close_Stale_Cases",
+			"Label":            "Close Stale Cases",
+			"ProcessType":      "AutoLaunchedFlow",
+			"TriggerType":      "Scheduled",
+			"ActiveVersionId":  "301xx0000000002",
+			"LatestVersionId":  "301xx0000000003",
+			"IsActive":         true,
+			"NamespacePrefix":  nil,
+			"LastModifiedDate": "2024-06-01T08:00:00.000+0000",
+		}
+		got := mapFlowRecord(record)
+		expected := flowRow{
+			ID:               "300xx0000000001",
+			APIName:          "Close_Stale_Cases",
+			Label:            "Close Stale Cases",
+			ProcessType:      "AutoLaunchedFlow",
+			TriggerType:      "Scheduled",
+			ActiveVersionID:  "301xx0000000002",
+			LatestVersionID:  "301xx0000000003",
+			IsActive:         true,
+			Status:           "Active",
+			LastModifiedDate: "2024-06-01T08:00:00.000+0000",
+		}
+		if got != expected {
+			t.Errorf("got %+v, want %+v", got, expected)
+		}
+	})
+
+	t.Run("inactive flow", func(t *testing.T) {
+		got := mapFlowRecord(map[string]interface{}{"DurableId": "300xx0000000001", "IsActive": false, "ActiveVersionId": nil, "TriggerType": nil})
+		if got.Status != "Inactive" || got.IsActive || got.ActiveVersionID != "" || got.TriggerType != "" {
+			t.Errorf("got %+v, want an inactive flow without active version", got)
+		}
+	})
+}
+
+func TestBuildFlowCondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		apiName     string
+		processType string
+		triggerType string
+		status      string
+		expected    string
+	}{
+		{"no quals", "", "", "", "", ""},
+		{"active", "", "", "", "Active", "IsActive = true"},
+		{"inactive", "", "", "", "Inactive", "IsActive = false"},
+		{"unknown status is left to postgres", "", "", "", "Draft", ""},
+		{"all quals", "Close_Stale_Cases", "AutoLaunchedFlow", "Scheduled", "Active", "ApiName = 'Close_Stale_Cases' AND ProcessType = 'AutoLaunchedFlow' AND TriggerType = 'Scheduled' AND IsActive = true"},
+		{"quote is escaped", "Flow' OR Label != '", "", "", "", `ApiName = 'Flow\' OR Label != \''`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildFlowCondition(tt.apiName, tt.processType, tt.triggerType, tt.status)
+			if got != tt.expected {
+				t.Errorf("buildFlowCondition() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Flow is only exposed by the Tooling API.
+const flowVersionQuery = "SELECT Id, DefinitionId, Definition.DeveloperName, Definition.ActiveVersionId, MasterLabel, ProcessType, Status, VersionNumber, ApiVersion, Description, CreatedDate, LastModifiedDate FROM Flow"
+
+type flowVersionRow struct {
+	ID               string
+	FlowDefinitionID string
+	APIName          string
+	Label            string
+	ProcessType      string
+	Status           string
+	VersionNumber    *int64
+	ActiveVersionID  string
+	IsActiveVersion  bool
+	APIVersion       *float64
+	Description      string
+	CreatedDate      string
+	LastModifiedDate string
+}
+
+func SalesforceFlowVersion(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_flow_version",
+		Description: "Represents a version of a flow, read with the Tooling API.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceFlowVersions,
+			KeyColumns: plugin.OptionalColumns([]string{"flow_definition_id", "api_name", "process_type", "status"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the flow version.", Transform: transform.FromField("ID")},
+			{Name: "flow_definition_id", Type: proto.ColumnType_STRING, Description: "The ID of the flow definition, i.e. the id of the flow in salesforce_flow.", Transform: transform.FromField("FlowDefinitionID")},
+			{Name: "api_name", Type: proto.ColumnType_STRING, Description: "The unique API name of the flow.", Transform: transform.FromField("APIName")},
+			{Name: "label", Type: proto.ColumnType_STRING, Description: "The label of the flow version.", Transform: transform.FromField("Label")},
+			{Name: "process_type", Type: proto.ColumnType_STRING, Description: "The type of the flow, for example Flow for screen flows, AutoLaunchedFlow or Workflow for processes built with Process Builder.", Transform: transform.FromField("ProcessType")},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the version: Active, Draft, Obsolete or InvalidDraft.", Transform: transform.FromField("Status")},
+			{Name: "version_number", Type: proto.ColumnType_INT, Description: "The number of the version, starting at 1.", Transform: transform.FromField("VersionNumber")},
+			{Name: "active_version_id", Type: proto.ColumnType_STRING, Description: "The ID of the active version of the flow, or null if no version is active.", Transform: transform.FromField("ActiveVersionID").NullIfZero()},
+			{Name: "is_active_version", Type: proto.ColumnType_BOOL, Description: "Indicates whether this version is the active version of the flow.", Transform: transform.FromField("IsActiveVersion")},
+			{Name: "api_version", Type: proto.ColumnType_DOUBLE, Description: "The API version of the flow version, e.g. 62.", Transform: transform.FromField("APIVersion")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the flow version.", Transform: transform.FromField("Description")},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the version was created.", Transform: transform.FromField("CreatedDate").NullIfZero()},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the version was last modified.", Transform: transform.FromField("LastModifiedDate").NullIfZero()},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceFlowVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_flow_version.listSalesforceFlowVersions", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_flow_version.listSalesforceFlowVersions: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	query := flowVersionQuery
+	if condition := buildFlowVersionCondition(d.EqualsQualString("flow_definition_id"), d.EqualsQualString("api_name"), d.EqualsQualString("process_type"), d.EqualsQualString("status")); condition != "" {
+		query = fmt.Sprintf("%s WHERE %s", query, condition)
+	}
+
+	_, err = streamToolingQueryRecords(ctx, d, client, "Flow", query, func(record map[string]interface{}) bool {
+		d.StreamListItem(ctx, mapFlowVersionRecord(record))
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_flow_version.listSalesforceFlowVersions", "query error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// buildFlowVersionCondition returns the SOQL WHERE condition for the optional
+// flow_definition_id, api_name, process_type and status quals.
+func buildFlowVersionCondition(flowDefinitionID, apiName, processType, status string) string {
+	filters := []string{}
+	if flowDefinitionID != "" {
+		filters = append(filters, fmt.Sprintf("DefinitionId = '%s'", escapeSOQLString(flowDefinitionID)))
+	}
+	if apiName != "" {
+		filters = append(filters, fmt.Sprintf("Definition.DeveloperName = '%s'", escapeSOQLString(apiName)))
+	}
+	if processType != "" {
+		filters = append(filters, fmt.Sprintf("ProcessType = '%s'", escapeSOQLString(processType)))
+	}
+	if status != "" {
+		filters = append(filters, fmt.Sprintf("Status = '%s'", escapeSOQLString(status)))
+	}
+	return strings.Join(filters, " AND ")
+}
+
+// mapFlowVersionRecord flattens a Flow record, including the parent
+// FlowDefinition relationship fields, into a flowVersionRow.
+func mapFlowVersionRecord(record map[string]interface{}) flowVersionRow {
+	row := flowVersionRow{}
+	row.ID, _ = record["Id"].(string)
+	row.FlowDefinitionID, _ = record["DefinitionId"].(string)
+	row.Label, _ = record["MasterLabel"].(string)
+	row.ProcessType, _ = record["ProcessType"].(string)
+	row.Status, _ = record["Status"].(string)
+	row.Description, _ = record["Description"].(string)
+	row.CreatedDate, _ = record["CreatedDate"].(string)
+	row.LastModifiedDate, _ = record["LastModifiedDate"].(string)
+	if versionNumber, ok := record["VersionNumber"].(float64); ok {
+		value := int64(versionNumber)
+		row.VersionNumber = &value
+	}
+	if apiVersion, ok := record["ApiVersion"].(float64); ok {
+		row.APIVersion = &apiVersion
+	}
+	if definition, ok := record["Definition"].(map[string]interface{}); ok {
+		row.APIName, _ = definition["DeveloperName"].(string)
+		row.ActiveVersionID, _ = definition["ActiveVersionId"].(string)
+	}
+	row.IsActiveVersion = row.ID != "" && row.ID == row.ActiveVersionID
+	return row
+}
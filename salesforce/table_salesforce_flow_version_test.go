@@ -0,0 +1,78 @@
+package salesforce
+
+import (
+	"testing"
+)
+
+func TestMapFlowVersionRecord(t *testing.T) {
+	t.Run("flattens parent definition fields", func(t *testing.T) {
+		record := map[string]interface{}{
+			"attributes":    map[string]interface{}{"type": "Flow"},
+			"Id":            "301xx0000000002",
+			"DefinitionId":  "300xx0000000001",
+			"MasterLabel":   "Close Stale Cases",
+			"ProcessType":   "AutoLaunchedFlow",
+			"Status":        "Active",
+			"VersionNumber": float64(2),
+			"ApiVersion":    float64(62),
+			"Definition": map[string]interface{}{
+				"attributes":      map[string]interface{}{"type": "FlowDefinition"},
+				"DeveloperName":   "Close_Stale_Cases",
+				"ActiveVersionId": "301xx0000000002",
+			},
+		}
+		got := mapFlowVersionRecord(record)
+		if got.ID != "301xx0000000002" || got.FlowDefinitionID != "300xx0000000001" || got.APIName != "Close_Stale_Cases" || got.Label != "Close Stale Cases" {
+			t.Errorf("got %+v", got)
+		}
+		if got.Status != "Active" || got.ActiveVersionID != "301xx0000000002" || !got.IsActiveVersion {
+			t.Errorf("got %+v, want the active version", got)
+		}
+		if got.VersionNumber == nil || *got.VersionNumber != 2 || got.APIVersion == nil || *got.APIVersion != 62 {
+			t.Errorf("version number = %v, api version = %v, want 2 and 62", got.VersionNumber, got.APIVersion)
+		}
+	})
+
+	t.Run("obsolete version", func(t *testing.T) {
+		got := mapFlowVersionRecord(map[string]interface{}{
+			"Id":         "301xx0000000001",
+			"Status":     "Obsolete",
+			"Definition": map[string]interface{}{"DeveloperName": "Close_Stale_Cases", "ActiveVersionId": "301xx0000000002"},
+		})
+		if got.IsActiveVersion || got.ActiveVersionID != "301xx0000000002" || got.VersionNumber != nil {
+			t.Errorf("got %+v, want an inactive version", got)
+		}
+	})
+
+	t.Run("missing definition relationship", func(t *testing.T) {
+		got := mapFlowVersionRecord(map[string]interface{}{"Id": "301xx0000000001", "Definition": nil})
+		if got.ID != "301xx0000000001" || got.APIName != "" || got.IsActiveVersion {
+			t.Errorf("got %+v, want only ID set", got)
+		}
+	})
+}
+
+func TestBuildFlowVersionCondition(t *testing.T) {
+	tests := []struct {
+		name             string
+		flowDefinitionID string
+		apiName          string
+		processType      string
+		status           string
+		expected         string
+	}{
+		{"no quals", "", "", "", "", ""},
+		{"status only", "", "", "", "Draft", "Status = 'Draft'"},
+		{"all quals", "300xx", "Close_Stale_Cases", "AutoLaunchedFlow", "Active", "DefinitionId = '300xx' AND Definition.DeveloperName = 'Close_Stale_Cases' AND ProcessType = 'AutoLaunchedFlow' AND Status = 'Active'"},
+		{"quote is escaped", "", "", "", "Active' OR Status != '", `Status = 'Active\' OR Status != \''`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildFlowVersionCondition(tt.flowDefinitionID, tt.apiName, tt.processType, tt.status)
+			if got != tt.expected {
+				t.Errorf("buildFlowVersionCondition() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const groupMemberQuery = "SELECT Id, GroupId, Group.Name, Group.DeveloperName, Group.Type, UserOrGroupId, SystemModstamp FROM GroupMember"
+
+type groupMemberRow struct {
+	ID                 string
+	GroupID            string
+	GroupName          string
+	GroupDeveloperName string
+	GroupType          string
+	UserOrGroupID      string
+	SystemModstamp     string
+}
+
+func SalesforceGroupMember(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_group_member",
+		Description: "Represents a user or group that is a member of a public group or queue.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceGroupMembers,
+			KeyColumns: plugin.OptionalColumns([]string{"group_id", "group_type", "user_or_group_id"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the group member.", Transform: transform.FromField("ID")},
+			{Name: "group_id", Type: proto.ColumnType_STRING, Description: "The ID of the group or queue.", Transform: transform.FromField("GroupID")},
+			{Name: "group_name", Type: proto.ColumnType_STRING, Description: "The label of the group or queue.", Transform: transform.FromField("GroupName")},
+			{Name: "group_developer_name", Type: proto.ColumnType_STRING, Description: "The unique API name of the group or queue.", Transform: transform.FromField("GroupDeveloperName")},
+			{Name: "group_type", Type: proto.ColumnType_STRING, Description: "The type of the group, for example Queue, Regular or Role.", Transform: transform.FromField("GroupType")},
+			{Name: "user_or_group_id", Type: proto.ColumnType_STRING, Description: "The ID of the user or group that is a member of the group.", Transform: transform.FromField("UserOrGroupID")},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the membership was last modified by a user or by an automated process.", Transform: transform.FromField("SystemModstamp").NullIfZero()},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceGroupMembers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_group_member.listSalesforceGroupMembers", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_group_member.listSalesforceGroupMembers: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	query := groupMemberQuery
+	if condition := buildGroupMemberCondition(d.EqualsQualString("group_id"), d.EqualsQualString("group_type"), d.EqualsQualString("user_or_group_id")); condition != "" {
+		query = fmt.Sprintf("%s WHERE %s", query, condition)
+	}
+
+	_, err = streamQueryRecords(ctx, d, client, "GroupMember", query, func(record map[string]interface{}) bool {
+		d.StreamListItem(ctx, mapGroupMemberRecord(record))
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_group_member.listSalesforceGroupMembers", "query error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// buildGroupMemberCondition returns the SOQL WHERE condition for the optional
+// group_id, group_type and user_or_group_id quals.
+func buildGroupMemberCondition(groupID, groupType, userOrGroupID string) string {
+	filters := []string{}
+	if groupID != "" {
+		filters = append(filters, fmt.Sprintf("GroupId = '%s'", escapeSOQLString(groupID)))
+	}
+	if groupType != "" {
+		filters = append(filters, fmt.Sprintf("Group.Type = '%s'", escapeSOQLString(groupType)))
+	}
+	if userOrGroupID != "" {
+		filters = append(filters, fmt.Sprintf("UserOrGroupId = '%s'", escapeSOQLString(userOrGroupID)))
+	}
+	return strings.Join(filters, " AND ")
+}
+
+// mapGroupMemberRecord flattens a GroupMember record, including the parent
+// Group relationship fields, into a groupMemberRow.
+func mapGroupMemberRecord(record map[string]interface{}) groupMemberRow {
+	row := groupMemberRow{}
+	row.ID, _ = record["Id"].(string)
+	row.GroupID, _ = record["GroupId"].(string)
+	row.UserOrGroupID, _ = record["UserOrGroupId"].(string)
+	row.SystemModstamp, _ = record["SystemModstamp"].(string)
+	if group, ok := record["Group"].(map[string]interface{}); ok {
+		row.GroupName, _ = group["Name"].(string)
+		row.GroupDeveloperName, _ = group["DeveloperName"].(string)
+		row.GroupType, _ = group["Type"].(string)
+	}
+	return row
+}
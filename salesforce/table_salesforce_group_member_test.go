@@ -0,0 +1,65 @@
+package salesforce
+
+import (
+	"testing"
+)
+
+func TestMapGroupMemberRecord(t *testing.T) {
+	t.Run("flattens parent group fields", func(t *testing.T) {
+		record := map[string]interface{}{
+			"attributes":    map[string]interface{}{"type": "GroupMember"},
+			"Id":            "011xx0000000001",
+			"GroupId":       "00Gxx0000000001",
+			"UserOrGroupId": "005xx0000000001",
+			"Group": map[string]interface{}{
+				"attributes":    map[string]interface{}{"type": "Group"},
+				"Name":          "Support Queue",
+				"DeveloperName": "Support_Queue",
+				"Type":          "Queue",
+			},
+		}
+		got := mapGroupMemberRecord(record)
+		expected := groupMemberRow{
+			ID:                 "011xx0000000001",
+			GroupID:            "00Gxx0000000001",
+			GroupName:          "Support Queue",
+			GroupDeveloperName: "Support_Queue",
+			GroupType:          "Queue",
+			UserOrGroupID:      "005xx0000000001",
+		}
+		if got != expected {
+			t.Errorf("got %+v, want %+v", got, expected)
+		}
+	})
+
+	t.Run("missing group relationship", func(t *testing.T) {
+		got := mapGroupMemberRecord(map[string]interface{}{"Id": "011xx0000000001", "Group": nil})
+		if got.ID != "011xx0000000001" || got.GroupName != "" || got.GroupType != "" {
+			t.Errorf("got %+v, want only ID set", got)
+		}
+	})
+}
+
+func TestBuildGroupMemberCondition(t *testing.T) {
+	tests := []struct {
+		name          string
+		groupID       string
+		groupType     string
+		userOrGroupID string
+		expected      string
+	}{
+		{"no quals", "", "", "", ""},
+		{"group type only", "", "Queue", "", "Group.Type = 'Queue'"},
+		{"all quals", "00Gxx", "Queue", "005xx", "GroupId = '00Gxx' AND Group.Type = 'Queue' AND UserOrGroupId = '005xx'"},
+		{"quote is escaped", "", "Queue' OR Type != '", "", `Group.Type = 'Queue\' OR Type != \''`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildGroupMemberCondition(tt.groupID, tt.groupType, tt.userOrGroupID)
+			if got != tt.expected {
+				t.Errorf("buildGroupMemberCondition() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -20,7 +20,7 @@ func SalesforceLead(ctx context.Context, dm dynamicMap, config salesforceConfig)
 			Hydrate:    getSalesforceObjectbyID(tableName),
 			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
 		},
-		Columns: mergeTableColumns(ctx, config, dm.cols, []*plugin.Column{
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
 			// Top columns
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the lead in Salesforce."},
 			{Name: "email", Type: proto.ColumnType_STRING, Description: "The lead's email address."},
@@ -33,7 +33,10 @@ func SalesforceLead(ctx context.Context, dm dynamicMap, config salesforceConfig)
 			{Name: "address", Type: proto.ColumnType_JSON, Description: "Street address for the lead."},
 			{Name: "annual_revenue", Type: proto.ColumnType_DOUBLE, Description: "Annual revenue for the lead's company."},
 			{Name: "company", Type: proto.ColumnType_STRING, Description: "The lead's company."},
+			{Name: "converted_account_id", Type: proto.ColumnType_STRING, Description: "ID of the account the lead was converted into, if it has been converted."},
+			{Name: "converted_contact_id", Type: proto.ColumnType_STRING, Description: "ID of the contact the lead was converted into, if it has been converted."},
 			{Name: "converted_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date on which this lead was converted."},
+			{Name: "converted_opportunity_id", Type: proto.ColumnType_STRING, Description: "ID of the opportunity created when the lead was converted, if any."},
 			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "Id of the user who created the lead."},
 			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "Creation date and time of the lead."},
 			{Name: "industry", Type: proto.ColumnType_STRING, Description: "Primary business of lead's company."},
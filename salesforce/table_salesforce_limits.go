@@ -0,0 +1,82 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type limitRow struct {
+	Name      string
+	Max       int64
+	Remaining int64
+	Used      int64
+}
+
+func SalesforceLimits(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_limits",
+		Description: "The org's limits, such as daily API requests and data storage, with their maximum and remaining allocations.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceLimits,
+			KeyColumns: plugin.OptionalColumns([]string{"name"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the limit, for example DailyApiRequests.", Transform: transform.FromField("Name")},
+			{Name: "max", Type: proto.ColumnType_INT, Description: "The maximum allocation of the limit.", Transform: transform.FromField("Max")},
+			{Name: "remaining", Type: proto.ColumnType_INT, Description: "The allocation of the limit that is still available.", Transform: transform.FromField("Remaining")},
+			{Name: "used", Type: proto.ColumnType_INT, Description: "The allocation of the limit that has been used, i.e. max minus remaining.", Transform: transform.FromField("Used")},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceLimits(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_limits.listSalesforceLimits", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_limits.listSalesforceLimits: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	limits, err := getOrgLimits(ctx, d, client)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_limits.listSalesforceLimits", "limits error", err)
+		return nil, err
+	}
+
+	for _, row := range buildLimitRows(limits, d.EqualsQualString("name")) {
+		d.StreamListItem(ctx, row)
+	}
+
+	return nil, nil
+}
+
+// buildLimitRows returns a row per limit, ordered by name, or only the limit
+// called name if it isn't empty.
+func buildLimitRows(limits map[string]orgLimit, name string) []limitRow {
+	rows := []limitRow{}
+	for limitName, limit := range limits {
+		if name != "" && limitName != name {
+			continue
+		}
+		rows = append(rows, limitRow{
+			Name:      limitName,
+			Max:       limit.Max,
+			Remaining: limit.Remaining,
+			Used:      limit.Max - limit.Remaining,
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Name < rows[j].Name
+	})
+	return rows
+}
@@ -0,0 +1,45 @@
+package salesforce
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBuildLimitRows(t *testing.T) {
+	// Some limits, such as DailyApiRequests, also break their usage down by
+	// connected app; only the org-wide allocation is returned.
+	var limits map[string]orgLimit
+	if err := json.Unmarshal([]byte(`{
+		"DataStorageMB": {"Max": 1024, "Remaining": 1000},
+		"DailyApiRequests": {"Max": 15000, "Remaining": 14250, "Ant Migration Tool": {"Max": 0, "Remaining": 0}},
+		"DailyBulkV2QueryJobs": {"Max": 10000, "Remaining": 10000}
+	}`), &limits); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	t.Run("all limits ordered by name", func(t *testing.T) {
+		rows := buildLimitRows(limits, "")
+		if len(rows) != 3 {
+			t.Fatalf("len = %d, want 3", len(rows))
+		}
+		if rows[0].Name != "DailyApiRequests" || rows[1].Name != "DailyBulkV2QueryJobs" || rows[2].Name != "DataStorageMB" {
+			t.Errorf("names = %s, %s, %s, want DailyApiRequests, DailyBulkV2QueryJobs, DataStorageMB", rows[0].Name, rows[1].Name, rows[2].Name)
+		}
+		if rows[0].Max != 15000 || rows[0].Remaining != 14250 || rows[0].Used != 750 {
+			t.Errorf("rows[0] = %+v, want 15000 max, 14250 remaining, 750 used", rows[0])
+		}
+	})
+
+	t.Run("filtered by name", func(t *testing.T) {
+		rows := buildLimitRows(limits, "DataStorageMB")
+		if len(rows) != 1 || rows[0].Name != "DataStorageMB" || rows[0].Used != 24 {
+			t.Errorf("rows = %+v, want only DataStorageMB with 24 used", rows)
+		}
+	})
+
+	t.Run("unknown name", func(t *testing.T) {
+		if rows := buildLimitRows(limits, "NoSuchLimit"); len(rows) != 0 {
+			t.Errorf("rows = %+v, want none", rows)
+		}
+	})
+}
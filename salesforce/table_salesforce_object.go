@@ -3,9 +3,9 @@ package salesforce
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 
-	"github.com/simpleforce/simpleforce"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
@@ -24,38 +24,74 @@ func listSalesforceObjectsByTable(tableName string, salesforceCols map[string]st
 			return nil, fmt.Errorf("salesforce.listSalesforceObjectsByTable: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
 		}
 
-		query := generateQuery(d.Table.Columns, tableName)
-		condition := buildQueryFromQuals(d.Quals, d.Table.Columns, salesforceCols)
+		query := generateQuery(queryColumns(d.Table.Columns, d.QueryContext.Columns), tableName)
+		condition := buildQueryFromQuals(d.Quals, d.Table.Columns, salesforceCols, GetConfig(d.Connection))
 		if condition != "" {
 			query = fmt.Sprintf("%s where %s", query, condition)
 			plugin.Logger(ctx).Debug("salesforce.listSalesforceObjectsByTable", "table_name", d.Table.Name, "query_condition", condition)
 		}
 
-		for {
-			var result *simpleforce.QueryResult
-			client, result, err = queryWithRetry(ctx, d, client, query)
+		// Scans that only count rows, like select count(*), are answered by a
+		// single SELECT COUNT() query instead of reading every record
+		if record, ok := countOnlyRecord(d.QueryContext.Columns, d.Quals, d.Table.Columns); ok {
+			count, err := countRecords(ctx, d, client, tableName, condition)
 			if err != nil {
-				plugin.Logger(ctx).Error("salesforce.listSalesforceObjectsByTable", "query error", err)
+				plugin.Logger(ctx).Error("salesforce.listSalesforceObjectsByTable", "count query error", err)
 				return nil, err
 			}
+			plugin.Logger(ctx).Debug("salesforce.listSalesforceObjectsByTable", "table_name", d.Table.Name, "record_count", count)
+			for i := 0; i < count; i++ {
+				d.StreamListItem(ctx, maps.Clone(record))
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					break
+				}
+			}
+			return nil, nil
+		}
 
-			AccountList := new([]map[string]interface{})
-			err = decodeQueryResult(ctx, result.Records, AccountList)
-			if err != nil {
-				plugin.Logger(ctx).Error("salesforce.listSalesforceObjectsByTable", "results decoding error", err)
+		orderBy := buildOrderByFromSortOrder(d.QueryContext.SortOrder, d.Table.Columns)
+		if orderBy != "" {
+			query = fmt.Sprintf("%s order by %s", query, orderBy)
+			plugin.Logger(ctx).Debug("salesforce.listSalesforceObjectsByTable", "table_name", d.Table.Name, "order_by", orderBy)
+		}
+		query = withQueryLimit(query, d.QueryContext, orderBy, d.Quals, d.Table.Columns, GetConfig(d.Connection))
+
+		stream := func(record map[string]interface{}) bool {
+			d.StreamListItem(ctx, record)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			return d.RowsRemaining(ctx) != 0
+		}
+
+		// Large scans are extracted with Bulk API 2.0 when enabled, falling back
+		// to REST if the org doesn't permit it
+		if bulkQuery := bulkQueryForTableScan(ctx, d, client, tableName, condition, orderBy, salesforceCols); bulkQuery != "" {
+			var streamed bool
+			client, streamed, err = runBulkQuery(ctx, d, client, tableName, bulkQuery, salesforceCols, stream)
+			if err == nil {
+				return nil, nil
+			}
+			if streamed || ctx.Err() != nil {
+				plugin.Logger(ctx).Error("salesforce.listSalesforceObjectsByTable", "bulk query error", err)
 				return nil, err
 			}
+			plugin.Logger(ctx).Warn("salesforce.listSalesforceObjectsByTable", "msg", "bulk query failed, falling back to REST", "table_name", d.Table.Name, "error", err)
+		}
 
-			for _, account := range *AccountList {
-				d.StreamListItem(ctx, account)
+		// Scans of objects with more than pk_chunk_size records are read in
+		// parallel ranges of Id
+		if queries := pkChunkQueries(ctx, d, client, tableName, query, condition, orderBy); queries != nil {
+			if err := runPKChunkQueries(ctx, d, client, tableName, queries, stream); err != nil {
+				plugin.Logger(ctx).Error("salesforce.listSalesforceObjectsByTable", "chunked query error", err)
+				return nil, err
 			}
+			return nil, nil
+		}
 
-			// Paging
-			if result.Done {
-				break
-			} else {
-				query = result.NextRecordsURL
-			}
+		_, err = streamQueryRecords(ctx, d, client, tableName, query, stream)
+		if err != nil {
+			plugin.Logger(ctx).Error("salesforce.listSalesforceObjectsByTable", "query error", err)
+			return nil, err
 		}
 
 		return nil, nil
@@ -88,21 +124,40 @@ func getSalesforceObjectbyID(tableName string) func(ctx context.Context, d *plug
 			return nil, fmt.Errorf("salesforce.getSalesforceObjectbyID: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
 		}
 
-		client, obj, err := getWithRetry(ctx, d, client, tableName, id)
+		// Concurrent Gets, e.g. for an id IN (...) filter, are combined into
+		// composite batch requests
+		record, err := getRecordBatched(ctx, d, client, tableName, id)
 		if err != nil {
 			plugin.Logger(ctx).Error("salesforce.getSalesforceObjectbyID", "get error", err)
 			return nil, err
 		}
-		if obj == nil {
+		if record == nil {
 			plugin.Logger(ctx).Warn("salesforce.getSalesforceObjectbyID", fmt.Sprintf("%s with id \"%s\" not found", tableName, id))
 			return nil, nil
 		}
-
-		object := new(map[string]interface{})
-		err = decodeQueryResult(ctx, obj, object)
-		if err != nil {
-			plugin.Logger(ctx).Error("salesforce.getSalesforceObjectbyID", "result decoding error", err)
-			return nil, err
+		object := &record
+
+		// Get() only returns the object's own fields, so the parent fields of
+		// requested relationship columns are read with a query
+		paths := []string{}
+		for _, column := range queryColumns(d.Table.Columns, d.QueryContext.Columns) {
+			if path, ok := isRelationshipColumn(column); ok {
+				paths = append(paths, string(path))
+			}
+		}
+		if len(paths) > 0 {
+			query := fmt.Sprintf("SELECT %s FROM %s WHERE Id = '%s'", strings.Join(paths, ", "), tableName, escapeSOQLString(id))
+			_, records, err := queryAllRecords(ctx, d, client, tableName, query)
+			if err != nil {
+				plugin.Logger(ctx).Error("salesforce.getSalesforceObjectbyID", "relationship query error", err)
+				return nil, err
+			}
+			if len(records) > 0 {
+				for _, path := range paths {
+					relationshipName := strings.Split(path, ".")[0]
+					(*object)[relationshipName] = records[0][relationshipName]
+				}
+			}
 		}
 
 		return *object, nil
@@ -117,6 +172,21 @@ func getFieldFromSObjectMap(ctx context.Context, d *transform.TransformData) (in
 	return ls[param], nil
 }
 
+// getFieldFromSObjectPath returns the parent field at the relationshipPath
+// param of the transform, e.g. record["Account"]["Owner"]["Name"] for
+// Account.Owner.Name, or nil if a parent record is not set.
+func getFieldFromSObjectPath(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	var value interface{} = d.HydrateItem
+	for _, name := range strings.Split(string(d.Param.(relationshipPath)), ".") {
+		record, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, nil
+		}
+		value = record[name]
+	}
+	return value, nil
+}
+
 func getFieldFromSObjectMapByColumnName(ctx context.Context, d *transform.TransformData) (interface{}, error) {
 	salesforceColumnName := getSalesforceColumnName(d.ColumnName)
 	ls := d.HydrateItem.(map[string]interface{})
@@ -0,0 +1,152 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type objectRelationshipRow struct {
+	FromObject            string
+	Field                 string
+	ToObject              string
+	RelationshipName      string
+	ChildRelationshipName string
+	CascadeDelete         bool
+}
+
+// objectRelationshipDescribe holds the parts of an sObject describe that
+// define its relationships.
+// Ref: https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_sobject_describe.htm
+type objectRelationshipDescribe struct {
+	Name   string `json:"name"`
+	Fields []struct {
+		Name             string   `json:"name"`
+		ReferenceTo      []string `json:"referenceTo"`
+		RelationshipName string   `json:"relationshipName"`
+		CascadeDelete    bool     `json:"cascadeDelete"`
+	} `json:"fields"`
+	ChildRelationships []struct {
+		ChildSObject     string `json:"childSObject"`
+		Field            string `json:"field"`
+		RelationshipName string `json:"relationshipName"`
+		CascadeDelete    bool   `json:"cascadeDelete"`
+	} `json:"childRelationships"`
+}
+
+func SalesforceObjectRelationship(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_object_relationship",
+		Description: "Relationships between Salesforce objects, one row per lookup or master-detail field and object it refers to.",
+		List: &plugin.ListConfig{
+			Hydrate: listSalesforceObjectRelationships,
+		},
+		Columns: []*plugin.Column{
+			{Name: "from_object", Type: proto.ColumnType_STRING, Description: "The API name of the object with the relationship field, i.e. the child object.", Transform: transform.FromField("FromObject")},
+			{Name: "field", Type: proto.ColumnType_STRING, Description: "The API name of the relationship field on the child object.", Transform: transform.FromField("Field")},
+			{Name: "to_object", Type: proto.ColumnType_STRING, Description: "The API name of the object the field refers to, i.e. the parent object.", Transform: transform.FromField("ToObject")},
+			{Name: "relationship_name", Type: proto.ColumnType_STRING, Description: "The name used to traverse the relationship from the child to the parent in SOQL, for example Account.", Transform: transform.FromField("RelationshipName").NullIfZero()},
+			{Name: "child_relationship_name", Type: proto.ColumnType_STRING, Description: "The name used to traverse the relationship from the parent to its children in SOQL, for example Contacts.", Transform: transform.FromField("ChildRelationshipName").NullIfZero()},
+			{Name: "cascade_delete", Type: proto.ColumnType_BOOL, Description: "True if deleting the parent record deletes the child records, as for master-detail relationships.", Transform: transform.FromField("CascadeDelete")},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceObjectRelationships(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_object_relationship.listSalesforceObjectRelationships", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_object_relationship.listSalesforceObjectRelationships: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	config := GetConfig(d.Connection)
+	describes := []simpleforce.SObjectMeta{}
+	for _, objectName := range configuredObjectNames(config) {
+		sObjectMeta := describeSObject(ctx, d.ConnectionCache, client, config, objectName)
+		if sObjectMeta == nil {
+			// Configured objects may not exist in every org
+			plugin.Logger(ctx).Warn("salesforce_object_relationship.listSalesforceObjectRelationships", "object_name", objectName, "msg", "object could not be described")
+			continue
+		}
+		describes = append(describes, *sObjectMeta)
+	}
+
+	rows, err := buildObjectRelationshipRows(describes)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_object_relationship.listSalesforceObjectRelationships", "describe decoding error", err)
+		return nil, err
+	}
+	for _, row := range rows {
+		d.StreamListItem(ctx, row)
+	}
+
+	return nil, nil
+}
+
+// buildObjectRelationshipRows extracts the relationship edges of the described
+// objects, from both their reference fields (edges to parents) and their child
+// relationships (edges from children). An edge seen from both ends, when both
+// objects are described, is returned once with the names from each side.
+// Rows are ordered by from_object, field and to_object.
+func buildObjectRelationshipRows(describes []simpleforce.SObjectMeta) ([]objectRelationshipRow, error) {
+	edges := map[string]*objectRelationshipRow{}
+	edge := func(fromObject, field, toObject string) *objectRelationshipRow {
+		key := fromObject + "." + field + "->" + toObject
+		if edges[key] == nil {
+			edges[key] = &objectRelationshipRow{FromObject: fromObject, Field: field, ToObject: toObject}
+		}
+		return edges[key]
+	}
+
+	for _, sObjectMeta := range describes {
+		data, err := json.Marshal(sObjectMeta)
+		if err != nil {
+			return nil, err
+		}
+		var describe objectRelationshipDescribe
+		if err := json.Unmarshal(data, &describe); err != nil {
+			return nil, fmt.Errorf("failed to parse describe of %v: %v", sObjectMeta["name"], err)
+		}
+
+		// Polymorphic fields, such as Task.WhoId, refer to several objects
+		for _, field := range describe.Fields {
+			for _, toObject := range field.ReferenceTo {
+				row := edge(describe.Name, field.Name, toObject)
+				row.RelationshipName = field.RelationshipName
+				row.CascadeDelete = row.CascadeDelete || field.CascadeDelete
+			}
+		}
+		for _, child := range describe.ChildRelationships {
+			row := edge(child.ChildSObject, child.Field, describe.Name)
+			row.ChildRelationshipName = child.RelationshipName
+			row.CascadeDelete = row.CascadeDelete || child.CascadeDelete
+		}
+	}
+
+	rows := []objectRelationshipRow{}
+	for _, row := range edges {
+		rows = append(rows, *row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].FromObject != rows[j].FromObject {
+			return rows[i].FromObject < rows[j].FromObject
+		}
+		if rows[i].Field != rows[j].Field {
+			return rows[i].Field < rows[j].Field
+		}
+		return rows[i].ToObject < rows[j].ToObject
+	})
+	return rows, nil
+}
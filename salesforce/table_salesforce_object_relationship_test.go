@@ -0,0 +1,77 @@
+package salesforce
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/simpleforce/simpleforce"
+)
+
+func TestBuildObjectRelationshipRows(t *testing.T) {
+	describe := func(data string) simpleforce.SObjectMeta {
+		var meta simpleforce.SObjectMeta
+		if err := json.Unmarshal([]byte(data), &meta); err != nil {
+			t.Fatalf("invalid describe: %v", err)
+		}
+		return meta
+	}
+
+	account := describe(`{
+		"name": "Account",
+		"fields": [
+			{"name": "Id", "type": "id", "referenceTo": []},
+			{"name": "ParentId", "type": "reference", "referenceTo": ["Account"], "relationshipName": "Parent"},
+			{"name": "Name", "type": "string"}
+		],
+		"childRelationships": [
+			{"childSObject": "Account", "field": "ParentId", "relationshipName": "ChildAccounts", "cascadeDelete": false},
+			{"childSObject": "Contact", "field": "AccountId", "relationshipName": "Contacts", "cascadeDelete": false},
+			{"childSObject": "Invoice__c", "field": "Account__c", "relationshipName": "Invoices__r", "cascadeDelete": true},
+			{"childSObject": "AccountHistory", "field": "AccountId", "relationshipName": null, "cascadeDelete": true}
+		]
+	}`)
+	contact := describe(`{
+		"name": "Contact",
+		"fields": [
+			{"name": "AccountId", "type": "reference", "referenceTo": ["Account"], "relationshipName": "Account"},
+			{"name": "OwnerId", "type": "reference", "referenceTo": ["User"], "relationshipName": "Owner"}
+		],
+		"childRelationships": []
+	}`)
+	task := describe(`{
+		"name": "Task",
+		"fields": [
+			{"name": "WhoId", "type": "reference", "referenceTo": ["Contact", "Lead"], "relationshipName": "Who"}
+		]
+	}`)
+
+	rows, err := buildObjectRelationshipRows([]simpleforce.SObjectMeta{account, contact, task})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []objectRelationshipRow{
+		{FromObject: "Account", Field: "ParentId", ToObject: "Account", RelationshipName: "Parent", ChildRelationshipName: "ChildAccounts"},
+		{FromObject: "AccountHistory", Field: "AccountId", ToObject: "Account", CascadeDelete: true},
+		{FromObject: "Contact", Field: "AccountId", ToObject: "Account", RelationshipName: "Account", ChildRelationshipName: "Contacts"},
+		{FromObject: "Contact", Field: "OwnerId", ToObject: "User", RelationshipName: "Owner"},
+		{FromObject: "Invoice__c", Field: "Account__c", ToObject: "Account", ChildRelationshipName: "Invoices__r", CascadeDelete: true},
+		{FromObject: "Task", Field: "WhoId", ToObject: "Contact", RelationshipName: "Who"},
+		{FromObject: "Task", Field: "WhoId", ToObject: "Lead", RelationshipName: "Who"},
+	}
+	if len(rows) != len(expected) {
+		t.Fatalf("rows = %+v, want %d rows", rows, len(expected))
+	}
+	for i := range expected {
+		if rows[i] != expected[i] {
+			t.Errorf("rows[%d] = %+v, want %+v", i, rows[i], expected[i])
+		}
+	}
+
+	t.Run("no describes", func(t *testing.T) {
+		rows, err := buildObjectRelationshipRows(nil)
+		if err != nil || len(rows) != 0 {
+			t.Errorf("rows = %+v, err = %v, want no rows", rows, err)
+		}
+	})
+}
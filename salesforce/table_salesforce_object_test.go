@@ -3,7 +3,10 @@ package salesforce
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
@@ -84,3 +87,75 @@ func TestGetFieldFromSObjectMapByColumnName(t *testing.T) {
 		}
 	})
 }
+
+func TestGetFieldFromSObjectPath(t *testing.T) {
+	ctx := context.Background()
+	record := map[string]interface{}{
+		"Id": "003xx0000001",
+		"Account": map[string]interface{}{
+			"attributes": map[string]interface{}{"type": "Account"},
+			"Name":       "Acme",
+			"Owner":      map[string]interface{}{"Name": "Jane Doe"},
+		},
+		"ReportsTo": nil,
+	}
+
+	tests := []struct {
+		path     relationshipPath
+		expected interface{}
+	}{
+		{"Account.Name", "Acme"},
+		{"Account.Owner.Name", "Jane Doe"},
+		{"ReportsTo.Name", nil},
+		{"Account.Parent.Name", nil},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.path), func(t *testing.T) {
+			got, err := getFieldFromSObjectPath(ctx, &transform.TransformData{Param: tt.path, HydrateItem: record})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// Salesforce returns dateTime values with a +0000 offset from the REST API and
+// with a Z from Bulk API; both must convert to the same timestamp column value.
+func TestTimestampColumnValues(t *testing.T) {
+	ctx := context.Background()
+	column := &plugin.Column{Name: "created_date", Type: proto.ColumnType_TIMESTAMP}
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Time
+	}{
+		{"offset", "2024-06-20T10:15:30.000+0000", time.Date(2024, 6, 20, 10, 15, 30, 0, time.UTC)},
+		{"zulu", "2024-06-20T10:15:30.000Z", time.Date(2024, 6, 20, 10, 15, 30, 0, time.UTC)},
+		{"no fractional seconds", "2024-06-20T10:15:30Z", time.Date(2024, 6, 20, 10, 15, 30, 0, time.UTC)},
+		{"fractional seconds", "2024-06-20T10:15:30.123+0000", time.Date(2024, 6, 20, 10, 15, 30, 123000000, time.UTC)},
+		{"colon offset", "2024-06-20T10:15:30.000+00:00", time.Date(2024, 6, 20, 10, 15, 30, 0, time.UTC)},
+		{"non-UTC offset", "2024-06-20T10:15:30.000-0700", time.Date(2024, 6, 20, 17, 15, 30, 0, time.UTC)},
+		{"date", "2024-06-20", time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := getFieldFromSObjectMapByColumnName(ctx, &transform.TransformData{
+				ColumnName:  column.Name,
+				HydrateItem: map[string]interface{}{"CreatedDate": tt.value},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, err := column.ToColumnValue(value)
+			if err != nil {
+				t.Fatalf("ToColumnValue(%q): %v", tt.value, err)
+			}
+			if !got.GetTimestampValue().AsTime().Equal(tt.expected) {
+				t.Errorf("ToColumnValue(%q) = %v, want %v", tt.value, got.GetTimestampValue().AsTime(), tt.expected)
+			}
+		})
+	}
+}
@@ -20,7 +20,7 @@ func SalesforceOpportunityContactRole(ctx context.Context, dm dynamicMap, config
 			Hydrate:    getSalesforceObjectbyID(tableName),
 			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
 		},
-		Columns: mergeTableColumns(ctx, config, dm.cols, []*plugin.Column{
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
 			// Top columns
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the opportunity contact role in Salesforce."},
 			{Name: "contact_id", Type: proto.ColumnType_STRING, Description: "ID of an associated Contact."},
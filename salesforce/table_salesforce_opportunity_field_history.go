@@ -0,0 +1,38 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceOpportunityFieldHistory(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "OpportunityFieldHistory"
+	return &plugin.Table{
+		Name:        "salesforce_opportunity_field_history",
+		Description: "Represents a change to a tracked field of an opportunity, with its old and new value.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the opportunity field history record in Salesforce."},
+			{Name: "opportunity_id", Type: proto.ColumnType_STRING, Description: "ID of the opportunity that changed."},
+			{Name: "field", Type: proto.ColumnType_STRING, Description: "API name of the field that changed, for example StageName or Amount."},
+			{Name: "old_value", Type: proto.ColumnType_JSON, Description: "The value of the field before the change."},
+			{Name: "new_value", Type: proto.ColumnType_JSON, Description: "The value of the field after the change."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time of the change."},
+
+			// Other columns
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who made the change."},
+			{Name: "data_type", Type: proto.ColumnType_STRING, Description: "The data type of the field that changed, for example Text or Currency."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "True if the history record has been moved to the Recycle Bin."},
+		}),
+	}
+}
@@ -0,0 +1,41 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceOpportunityHistory(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "OpportunityHistory"
+	return &plugin.Table{
+		Name:        "salesforce_opportunity_history",
+		Description: "Represents the stage history of an opportunity, with one row for each change to its stage, amount, probability or close date.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the opportunity history record in Salesforce."},
+			{Name: "opportunity_id", Type: proto.ColumnType_STRING, Description: "ID of the opportunity that changed."},
+			{Name: "stage_name", Type: proto.ColumnType_STRING, Description: "The stage of the opportunity after the change."},
+			{Name: "amount", Type: proto.ColumnType_DOUBLE, Description: "The estimated total sale amount of the opportunity after the change."},
+			{Name: "probability", Type: proto.ColumnType_DOUBLE, Description: "The percentage of estimated confidence in closing the opportunity after the change."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time of the change."},
+
+			// Other columns
+			{Name: "close_date", Type: proto.ColumnType_TIMESTAMP, Description: "The expected close date of the opportunity after the change."},
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who made the change."},
+			{Name: "expected_revenue", Type: proto.ColumnType_DOUBLE, Description: "The amount multiplied by the probability after the change."},
+			{Name: "forecast_category", Type: proto.ColumnType_STRING, Description: "The forecast category of the opportunity after the change, for example Pipeline, BestCase or Closed."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "True if the history record has been moved to the Recycle Bin."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the record was last modified by a user or by an automated process."},
+		}),
+	}
+}
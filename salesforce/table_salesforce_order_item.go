@@ -0,0 +1,52 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceOrderItem(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "OrderItem"
+	return &plugin.Table{
+		Name:        "salesforce_order_item",
+		Description: "Represents a product on an order, with its quantity and price.",
+		List: &plugin.ListConfig{
+			Hydrate: listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			// Order items are listed one order at a time, like the related list
+			// of the order
+			KeyColumns: requireKeyColumn(dm.keyColumns, checkColumnNameScheme(config, dm.cols, "order_id")),
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the order item in Salesforce."},
+			{Name: "order_id", Type: proto.ColumnType_STRING, Description: "ID of the order of the item."},
+			{Name: "order_item_number", Type: proto.ColumnType_STRING, Description: "Automatically generated number that identifies the order item."},
+			{Name: "product_2_id", Type: proto.ColumnType_STRING, Description: "ID of the product of the order item."},
+			{Name: "quantity", Type: proto.ColumnType_DOUBLE, Description: "Number of units of the product. Negative for items of a reduction order."},
+			{Name: "unit_price", Type: proto.ColumnType_DOUBLE, Description: "Price of one unit of the product."},
+			{Name: "total_price", Type: proto.ColumnType_DOUBLE, Description: "Price of the order item, i.e. quantity times unit_price."},
+
+			// Other columns
+			{Name: "available_quantity", Type: proto.ColumnType_DOUBLE, Description: "Quantity of the order item that can still be reduced by reduction orders."},
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the order item."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the creation of the order item."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "Description of the order item."},
+			{Name: "end_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date when the order item ends, for services and subscriptions."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the order item has been moved to the Recycle Bin (true) or not (false)."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who most recently changed the order item."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the most recent change to the order item."},
+			{Name: "list_price", Type: proto.ColumnType_DOUBLE, Description: "Price of the product in the price book of the order."},
+			{Name: "original_order_item_id", Type: proto.ColumnType_STRING, Description: "ID of the order item that this item of a reduction order reduces."},
+			{Name: "pricebook_entry_id", Type: proto.ColumnType_STRING, Description: "ID of the price book entry of the product."},
+			{Name: "quote_line_item_id", Type: proto.ColumnType_STRING, Description: "ID of the quote line item the order item was created from."},
+			{Name: "service_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date when the product is to be delivered or the service started."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the order item was last modified by a user or by an automated process."},
+		}),
+	}
+}
@@ -20,7 +20,7 @@ func SalesforcePermissionSet(ctx context.Context, dm dynamicMap, config salesfor
 			Hydrate:    getSalesforceObjectbyID(tableName),
 			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
 		},
-		Columns: mergeTableColumns(ctx, config, dm.cols, []*plugin.Column{
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
 			// Top columns
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "The unique id of the permission set."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The permission set unique name in the API."},
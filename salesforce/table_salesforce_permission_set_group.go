@@ -0,0 +1,216 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const (
+	permissionSetGroupQuery          = "SELECT Id, DeveloperName, MasterLabel, Description, Status, HasActivationRequired, NamespacePrefix, CreatedDate, LastModifiedDate FROM PermissionSetGroup"
+	permissionSetGroupComponentQuery = "SELECT PermissionSetGroupId, PermissionSetId, PermissionSet.Name, PermissionSet.Label, PermissionSet.Type FROM PermissionSetGroupComponent"
+	// The permissions of a group are calculated into a permission set owned by
+	// the group, whose object and field permissions are those of the group.
+	groupPermissionSetQuery = "SELECT Id, PermissionSetGroupId FROM PermissionSet WHERE PermissionSetGroupId != null"
+)
+
+// permissionSetTypeMuting is the type of the permission set of a group that
+// removes permissions granted by its other permission sets.
+const permissionSetTypeMuting = "Muting"
+
+type permissionSetGroupRow struct {
+	ID                    string
+	DeveloperName         string
+	MasterLabel           string
+	Description           string
+	Status                string
+	HasActivationRequired bool
+	NamespacePrefix       string
+	CreatedDate           string
+	LastModifiedDate      string
+	PermissionSetID       string
+	PermissionSets        []permissionSetRef
+	MutingPermissionSetID string
+}
+
+// permissionSetRef is a permission set of a group.
+type permissionSetRef struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Label string `json:"label"`
+}
+
+// permissionSetGroupComponents are the permission sets of a group, as read
+// from its PermissionSetGroupComponent records.
+type permissionSetGroupComponents struct {
+	PermissionSets        []permissionSetRef
+	MutingPermissionSetID string
+}
+
+func SalesforcePermissionSetGroup(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_permission_set_group",
+		Description: "Represents a permission set group, with the permission sets it combines and the permission set holding its calculated permissions.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforcePermissionSetGroups,
+			KeyColumns: plugin.OptionalColumns([]string{"id", "developer_name", "status"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the permission set group.", Transform: transform.FromField("ID")},
+			{Name: "developer_name", Type: proto.ColumnType_STRING, Description: "The unique API name of the permission set group.", Transform: transform.FromField("DeveloperName")},
+			{Name: "master_label", Type: proto.ColumnType_STRING, Description: "The label of the permission set group.", Transform: transform.FromField("MasterLabel")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the permission set group.", Transform: transform.FromField("Description").NullIfZero()},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the calculation of the permissions of the group: Updated, Outdated, Updating or Failed.", Transform: transform.FromField("Status")},
+			{Name: "has_activation_required", Type: proto.ColumnType_BOOL, Description: "Indicates whether the permission set group requires an associated active session.", Transform: transform.FromField("HasActivationRequired")},
+			{Name: "namespace_prefix", Type: proto.ColumnType_STRING, Description: "The namespace prefix of the managed package the group was installed from, or null.", Transform: transform.FromField("NamespacePrefix").NullIfZero()},
+			{Name: "permission_set_id", Type: proto.ColumnType_STRING, Description: "The ID of the permission set holding the calculated permissions of the group, i.e. the permissions of its permission sets less those of its muting permission set. Join on it with salesforce_object_permission or salesforce_field_permission.", Transform: transform.FromField("PermissionSetID").NullIfZero()},
+			{Name: "permission_sets", Type: proto.ColumnType_JSON, Description: "The permission sets combined by the group, with their id, name and label, sorted by name. The muting permission set isn't included.", Transform: transform.FromField("PermissionSets")},
+			{Name: "muting_permission_set_id", Type: proto.ColumnType_STRING, Description: "The ID of the muting permission set of the group, which removes permissions granted by its other permission sets, or null.", Transform: transform.FromField("MutingPermissionSetID").NullIfZero()},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the permission set group was created.", Transform: transform.FromField("CreatedDate").NullIfZero()},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the permission set group was last modified.", Transform: transform.FromField("LastModifiedDate").NullIfZero()},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforcePermissionSetGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_permission_set_group.listSalesforcePermissionSetGroups", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_permission_set_group.listSalesforcePermissionSetGroups: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	id := d.EqualsQualString("id")
+	query := permissionSetGroupQuery
+	if condition := buildPermissionSetGroupCondition(id, d.EqualsQualString("developer_name"), d.EqualsQualString("status")); condition != "" {
+		query = fmt.Sprintf("%s WHERE %s", query, condition)
+	}
+	client, groups, err := queryAllRecords(ctx, d, client, "PermissionSetGroup", query)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_permission_set_group.listSalesforcePermissionSetGroups", "query error", err)
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	// The permission sets of every group are read at once, or those of the
+	// group with the given id
+	componentQuery, permissionSetQuery := permissionSetGroupComponentQuery, groupPermissionSetQuery
+	if id != "" {
+		componentQuery = fmt.Sprintf("%s WHERE PermissionSetGroupId = '%s'", componentQuery, escapeSOQLString(id))
+		permissionSetQuery = fmt.Sprintf("%s AND PermissionSetGroupId = '%s'", permissionSetQuery, escapeSOQLString(id))
+	}
+	client, components, err := queryAllRecords(ctx, d, client, "PermissionSetGroupComponent", componentQuery)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_permission_set_group.listSalesforcePermissionSetGroups", "component query error", err)
+		return nil, err
+	}
+	_, permissionSets, err := queryAllRecords(ctx, d, client, "PermissionSet", permissionSetQuery)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_permission_set_group.listSalesforcePermissionSetGroups", "permission set query error", err)
+		return nil, err
+	}
+
+	componentsByGroup := aggregatePermissionSetGroupComponents(components)
+	permissionSetByGroup := map[string]string{}
+	for _, permissionSet := range permissionSets {
+		groupID, _ := permissionSet["PermissionSetGroupId"].(string)
+		permissionSetByGroup[groupID], _ = permissionSet["Id"].(string)
+	}
+	for _, group := range groups {
+		row := mapPermissionSetGroupRecord(group)
+		row.PermissionSetID = permissionSetByGroup[row.ID]
+		if components, ok := componentsByGroup[row.ID]; ok {
+			row.PermissionSets = components.PermissionSets
+			row.MutingPermissionSetID = components.MutingPermissionSetID
+		}
+		d.StreamListItem(ctx, row)
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+// buildPermissionSetGroupCondition returns the SOQL WHERE condition for the
+// optional id, developer_name and status quals.
+func buildPermissionSetGroupCondition(id, developerName, status string) string {
+	filters := []string{}
+	if id != "" {
+		filters = append(filters, fmt.Sprintf("Id = '%s'", escapeSOQLString(id)))
+	}
+	if developerName != "" {
+		filters = append(filters, fmt.Sprintf("DeveloperName = '%s'", escapeSOQLString(developerName)))
+	}
+	if status != "" {
+		filters = append(filters, fmt.Sprintf("Status = '%s'", escapeSOQLString(status)))
+	}
+	return strings.Join(filters, " AND ")
+}
+
+// mapPermissionSetGroupRecord converts a PermissionSetGroup record into a
+// permissionSetGroupRow, without its permission sets.
+func mapPermissionSetGroupRecord(record map[string]interface{}) permissionSetGroupRow {
+	row := permissionSetGroupRow{PermissionSets: []permissionSetRef{}}
+	row.ID, _ = record["Id"].(string)
+	row.DeveloperName, _ = record["DeveloperName"].(string)
+	row.MasterLabel, _ = record["MasterLabel"].(string)
+	row.Description, _ = record["Description"].(string)
+	row.Status, _ = record["Status"].(string)
+	row.HasActivationRequired, _ = record["HasActivationRequired"].(bool)
+	row.NamespacePrefix, _ = record["NamespacePrefix"].(string)
+	row.CreatedDate, _ = record["CreatedDate"].(string)
+	row.LastModifiedDate, _ = record["LastModifiedDate"].(string)
+	return row
+}
+
+// aggregatePermissionSetGroupComponents groups PermissionSetGroupComponent
+// records by permission set group. The muting permission set of a group is
+// kept apart from the permission sets it combines, which are sorted by name.
+func aggregatePermissionSetGroupComponents(records []map[string]interface{}) map[string]*permissionSetGroupComponents {
+	groups := map[string]*permissionSetGroupComponents{}
+	for _, record := range records {
+		groupID, _ := record["PermissionSetGroupId"].(string)
+		if groupID == "" {
+			continue
+		}
+		components, ok := groups[groupID]
+		if !ok {
+			components = &permissionSetGroupComponents{PermissionSets: []permissionSetRef{}}
+			groups[groupID] = components
+		}
+
+		permissionSet := permissionSetRef{}
+		permissionSet.ID, _ = record["PermissionSetId"].(string)
+		var permissionSetType string
+		if parent, ok := record["PermissionSet"].(map[string]interface{}); ok {
+			permissionSet.Name, _ = parent["Name"].(string)
+			permissionSet.Label, _ = parent["Label"].(string)
+			permissionSetType, _ = parent["Type"].(string)
+		}
+		if permissionSetType == permissionSetTypeMuting {
+			components.MutingPermissionSetID = permissionSet.ID
+			continue
+		}
+		components.PermissionSets = append(components.PermissionSets, permissionSet)
+	}
+	for _, components := range groups {
+		slices.SortFunc(components.PermissionSets, func(a, b permissionSetRef) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+	}
+	return groups
+}
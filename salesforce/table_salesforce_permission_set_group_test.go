@@ -0,0 +1,133 @@
+package salesforce
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMapPermissionSetGroupRecord(t *testing.T) {
+	record := map[string]interface{}{
+		"attributes":            map[string]interface{}{"type": "PermissionSetGroup"},
+		"Id":                    "0PGxx0000000001",
+		"DeveloperName":         "Sales_Ops",
+		"MasterLabel":           "Sales Ops",
+		"Description":           nil,
+		"Status":                "Updated",
+		"HasActivationRequired": true,
+		"NamespacePrefix":       nil,
+		"CreatedDate":           "2024-01-02T03:04:05.000+0000",
+		"LastModifiedDate":      "2024-02-03T04:05:06.000+0000",
+	}
+	got := mapPermissionSetGroupRecord(record)
+	expected := permissionSetGroupRow{
+		ID:                    "0PGxx0000000001",
+		DeveloperName:         "Sales_Ops",
+		MasterLabel:           "Sales Ops",
+		Status:                "Updated",
+		HasActivationRequired: true,
+		CreatedDate:           "2024-01-02T03:04:05.000+0000",
+		LastModifiedDate:      "2024-02-03T04:05:06.000+0000",
+		PermissionSets:        []permissionSetRef{},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %+v, want %+v", got, expected)
+	}
+}
+
+func TestBuildPermissionSetGroupCondition(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		developerName string
+		status        string
+		expected      string
+	}{
+		{"no quals", "", "", "", ""},
+		{"status only", "", "", "Outdated", "Status = 'Outdated'"},
+		{"all quals", "0PGxx", "Sales_Ops", "Updated", "Id = '0PGxx' AND DeveloperName = 'Sales_Ops' AND Status = 'Updated'"},
+		{"quote is escaped", "", "Sales' OR Id != '", "", `DeveloperName = 'Sales\' OR Id != \''`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildPermissionSetGroupCondition(tt.id, tt.developerName, tt.status)
+			if got != tt.expected {
+				t.Errorf("got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAggregatePermissionSetGroupComponents(t *testing.T) {
+	component := func(groupID, permissionSetID, name, permissionSetType string) map[string]interface{} {
+		return map[string]interface{}{
+			"attributes":           map[string]interface{}{"type": "PermissionSetGroupComponent"},
+			"PermissionSetGroupId": groupID,
+			"PermissionSetId":      permissionSetID,
+			"PermissionSet": map[string]interface{}{
+				"attributes": map[string]interface{}{"type": "PermissionSet"},
+				"Name":       name,
+				"Label":      name + " Label",
+				"Type":       permissionSetType,
+			},
+		}
+	}
+
+	t.Run("groups permission sets sorted by name", func(t *testing.T) {
+		got := aggregatePermissionSetGroupComponents([]map[string]interface{}{
+			component("0PGxx0000000001", "0PSxx0000000002", "Reports", "Regular"),
+			component("0PGxx0000000002", "0PSxx0000000003", "Cases", "Regular"),
+			component("0PGxx0000000001", "0PSxx0000000001", "Accounts", "Regular"),
+		})
+		expected := map[string]*permissionSetGroupComponents{
+			"0PGxx0000000001": {PermissionSets: []permissionSetRef{
+				{ID: "0PSxx0000000001", Name: "Accounts", Label: "Accounts Label"},
+				{ID: "0PSxx0000000002", Name: "Reports", Label: "Reports Label"},
+			}},
+			"0PGxx0000000002": {PermissionSets: []permissionSetRef{
+				{ID: "0PSxx0000000003", Name: "Cases", Label: "Cases Label"},
+			}},
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("got %+v, want %+v", got, expected)
+		}
+	})
+
+	t.Run("muting permission set is kept apart", func(t *testing.T) {
+		got := aggregatePermissionSetGroupComponents([]map[string]interface{}{
+			component("0PGxx0000000001", "0PSxx0000000001", "Accounts", "Regular"),
+			component("0PGxx0000000001", "0PSxx0000000009", "Sales_Ops_Muting", "Muting"),
+		})
+		expected := map[string]*permissionSetGroupComponents{
+			"0PGxx0000000001": {
+				PermissionSets:        []permissionSetRef{{ID: "0PSxx0000000001", Name: "Accounts", Label: "Accounts Label"}},
+				MutingPermissionSetID: "0PSxx0000000009",
+			},
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("got %+v, want %+v", got, expected)
+		}
+	})
+
+	t.Run("group with only a muting permission set", func(t *testing.T) {
+		got := aggregatePermissionSetGroupComponents([]map[string]interface{}{
+			component("0PGxx0000000001", "0PSxx0000000009", "Sales_Ops_Muting", "Muting"),
+		})
+		if components := got["0PGxx0000000001"]; components == nil || components.PermissionSets == nil || len(components.PermissionSets) != 0 {
+			t.Errorf("got %+v, want an empty list of permission sets", components)
+		}
+	})
+
+	t.Run("skips records without a group and a missing relationship", func(t *testing.T) {
+		got := aggregatePermissionSetGroupComponents([]map[string]interface{}{
+			{"PermissionSetGroupId": nil, "PermissionSetId": "0PSxx0000000001"},
+			{"PermissionSetGroupId": "0PGxx0000000001", "PermissionSetId": "0PSxx0000000002", "PermissionSet": nil},
+		})
+		expected := map[string]*permissionSetGroupComponents{
+			"0PGxx0000000001": {PermissionSets: []permissionSetRef{{ID: "0PSxx0000000002"}}},
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("got %+v, want %+v", got, expected)
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type picklistValueRow struct {
+	SObject      string
+	Field        string
+	Value        string
+	Label        string
+	Active       bool
+	DefaultValue bool
+}
+
+func SalesforcePicklistValue(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_picklist_value",
+		Description: "The values of the picklist fields of a Salesforce object, such as the stages of Opportunity.StageName.",
+		List: &plugin.ListConfig{
+			Hydrate: listSalesforcePicklistValues,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "sobject", Require: plugin.Required},
+				{Name: "field", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{Name: "sobject", Type: proto.ColumnType_STRING, Description: "The API name of the object, for example Opportunity.", Transform: transform.FromField("SObject")},
+			{Name: "field", Type: proto.ColumnType_STRING, Description: "The API name of the picklist field, for example StageName.", Transform: transform.FromField("Field")},
+			{Name: "value", Type: proto.ColumnType_STRING, Description: "The value stored in records, as used in SOQL.", Transform: transform.FromField("Value")},
+			{Name: "label", Type: proto.ColumnType_STRING, Description: "The label of the value displayed to users.", Transform: transform.FromField("Label")},
+			{Name: "active", Type: proto.ColumnType_BOOL, Description: "True if the value can be selected; inactive values may still be set on existing records.", Transform: transform.FromField("Active")},
+			{Name: "default_value", Type: proto.ColumnType_BOOL, Description: "True if the value is the default value of the field.", Transform: transform.FromField("DefaultValue")},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforcePicklistValues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	objectName := d.EqualsQualString("sobject")
+	if objectName == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_picklist_value.listSalesforcePicklistValues", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_picklist_value.listSalesforcePicklistValues: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	sObjectMeta := describeSObject(ctx, d.ConnectionCache, client, GetConfig(d.Connection), objectName)
+	if sObjectMeta == nil {
+		// Like a filter on a missing record, an unknown object has no values
+		plugin.Logger(ctx).Warn("salesforce_picklist_value.listSalesforcePicklistValues", "object_name", objectName, "msg", "object could not be described")
+		return nil, nil
+	}
+
+	rows, err := buildPicklistValueRows(objectName, sObjectMeta, d.EqualsQualString("field"))
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_picklist_value.listSalesforcePicklistValues", "describe decoding error", err)
+		return nil, err
+	}
+	for _, row := range rows {
+		d.StreamListItem(ctx, row)
+	}
+
+	return nil, nil
+}
+
+// buildPicklistValueRows returns a row per value of each picklist field of
+// objectName, or only of the field called fieldName if it isn't empty. Fields
+// and values keep the order of the describe, which for values is the order
+// users see them in. Rows keep objectName as requested, rather than the
+// describe's spelling, so they match the sobject qual.
+func buildPicklistValueRows(objectName string, sObjectMeta *simpleforce.SObjectMeta, fieldName string) ([]picklistValueRow, error) {
+	fields, err := describeFields(sObjectMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []picklistValueRow{}
+	for _, field := range fields {
+		if fieldName != "" && field.Name != fieldName {
+			continue
+		}
+		for _, value := range field.PicklistValues {
+			rows = append(rows, picklistValueRow{
+				SObject:      objectName,
+				Field:        field.Name,
+				Value:        value.Value,
+				Label:        value.Label,
+				Active:       value.Active,
+				DefaultValue: value.DefaultValue,
+			})
+		}
+	}
+	return rows, nil
+}
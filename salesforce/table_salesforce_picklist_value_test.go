@@ -0,0 +1,67 @@
+package salesforce
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/simpleforce/simpleforce"
+)
+
+func TestBuildPicklistValueRows(t *testing.T) {
+	var meta simpleforce.SObjectMeta
+	if err := json.Unmarshal([]byte(`{
+		"name": "Opportunity",
+		"fields": [
+			{"name": "Name", "type": "string", "picklistValues": []},
+			{"name": "StageName", "type": "picklist", "picklistValues": [
+				{"value": "Prospecting", "label": "Prospecting", "active": true, "defaultValue": true},
+				{"value": "Closed Won", "label": "Closed Won", "active": true, "defaultValue": false},
+				{"value": "Legacy", "label": "Legacy Stage", "active": false, "defaultValue": false}
+			]},
+			{"name": "Region__c", "type": "multipicklist", "picklistValues": [
+				{"value": "EMEA", "label": "Europe", "active": true, "defaultValue": false}
+			]}
+		]
+	}`), &meta); err != nil {
+		t.Fatalf("invalid describe: %v", err)
+	}
+
+	t.Run("every picklist field", func(t *testing.T) {
+		rows, err := buildPicklistValueRows("Opportunity", &meta, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		values := []string{}
+		for _, row := range rows {
+			values = append(values, row.Field+"="+row.Value)
+		}
+		if expected := []string{"StageName=Prospecting", "StageName=Closed Won", "StageName=Legacy", "Region__c=EMEA"}; !slices.Equal(values, expected) {
+			t.Errorf("values = %v, want %v", values, expected)
+		}
+		expected := picklistValueRow{SObject: "Opportunity", Field: "StageName", Value: "Prospecting", Label: "Prospecting", Active: true, DefaultValue: true}
+		if rows[0] != expected {
+			t.Errorf("rows[0] = %+v, want %+v", rows[0], expected)
+		}
+		if rows[2].Active || rows[2].Label != "Legacy Stage" {
+			t.Errorf("rows[2] = %+v, want the inactive Legacy Stage", rows[2])
+		}
+	})
+
+	t.Run("filtered by field", func(t *testing.T) {
+		rows, err := buildPicklistValueRows("opportunity", &meta, "Region__c")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := []picklistValueRow{{SObject: "opportunity", Field: "Region__c", Value: "EMEA", Label: "Europe", Active: true}}
+		if !slices.Equal(rows, expected) {
+			t.Errorf("rows = %+v, want %+v", rows, expected)
+		}
+	})
+
+	t.Run("object not described", func(t *testing.T) {
+		if _, err := buildPicklistValueRows("Widget", nil, ""); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
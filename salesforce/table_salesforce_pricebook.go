@@ -20,7 +20,7 @@ func SalesforcePricebook(ctx context.Context, dm dynamicMap, config salesforceCo
 			Hydrate:    getSalesforceObjectbyID(tableName),
 			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
 		},
-		Columns: mergeTableColumns(ctx, config, dm.cols, []*plugin.Column{
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
 			// Top columns
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the product in pricebook."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The Price Book Name."},
@@ -37,7 +37,7 @@ func SalesforcePricebook(ctx context.Context, dm dynamicMap, config salesforceCo
 			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date of most recent change in the product record."},
 			{Name: "last_referenced_date", Type: proto.ColumnType_TIMESTAMP, Description: "The timestamp for when the current user last viewed a record related to this record."},
 			{Name: "last_viewed_date", Type: proto.ColumnType_TIMESTAMP, Description: "The timestamp for when the current user last viewed this record. If this value is null, it's possible that this record was referenced (LastReferencedDate) and not viewed."},
-			{Name: "system_modstamp", Type: proto.ColumnType_STRING, Description: "The date and time when order record was last modified by a user or by an automated process."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when order record was last modified by a user or by an automated process."},
 		}),
 	}
 }
@@ -0,0 +1,44 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforcePricebookEntry(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "PricebookEntry"
+	return &plugin.Table{
+		Name:        "salesforce_pricebook_entry",
+		Description: "Represents a product entry (an association between a Pricebook2 and Product2) in a price book.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the price book entry in Salesforce."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "Name of the price book entry, which is the name of its product."},
+			{Name: "pricebook_2_id", Type: proto.ColumnType_STRING, Description: "ID of the price book that contains the entry."},
+			{Name: "product_2_id", Type: proto.ColumnType_STRING, Description: "ID of the product that the entry prices."},
+			{Name: "unit_price", Type: proto.ColumnType_DOUBLE, Description: "Price of the product in the price book. In multi-currency orgs, the price is in the currency of the currency_iso_code column."},
+			{Name: "is_active", Type: proto.ColumnType_BOOL, Description: "Indicates whether the price book entry is active (true) or not (false)."},
+
+			// Other columns
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the price book entry."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the creation of the price book entry."},
+			{Name: "is_archived", Type: proto.ColumnType_BOOL, Description: "Indicates whether the price book entry is archived (true) or not (false)."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the price book entry has been moved to the Recycle Bin (true) or not (false)."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who most recently changed the price book entry."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the most recent change to the price book entry."},
+			{Name: "product_code", Type: proto.ColumnType_STRING, Description: "Product code of the entry's product."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the price book entry was last modified by a user or by an automated process."},
+			{Name: "use_standard_price", Type: proto.ColumnType_BOOL, Description: "Indicates whether the entry uses the price of the product in the standard price book (true) or its own unit_price (false)."},
+		}),
+	}
+}
@@ -0,0 +1,41 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceProcessInstance(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "ProcessInstance"
+	return &plugin.Table{
+		Name:        "salesforce_process_instance",
+		Description: "Represents an instance of a single, end-to-end approval process.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the process instance in Salesforce."},
+			{Name: "process_definition_id", Type: proto.ColumnType_STRING, Description: "ID of the approval process being run."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the approval, for example Approved, Pending, Rejected, Removed or Started."},
+			{Name: "target_object_id", Type: proto.ColumnType_STRING, Description: "ID of the record that is being approved."},
+			{Name: "submitted_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who submitted the record for approval."},
+
+			// Other columns
+			{Name: "completed_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the approval process was completed."},
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the process instance."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the record was submitted for approval."},
+			{Name: "elapsed_time_in_days", Type: proto.ColumnType_DOUBLE, Description: "The time in days since the approval process was started."},
+			{Name: "last_actor_id", Type: proto.ColumnType_STRING, Description: "ID of the user who last acted on the approval process."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who most recently changed the process instance."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time of the most recent change to the process instance."},
+		}),
+	}
+}
@@ -0,0 +1,39 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceProcessInstanceStep(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "ProcessInstanceStep"
+	return &plugin.Table{
+		Name:        "salesforce_process_instance_step",
+		Description: "Represents a step that has been completed in an approval process, such as an approval or rejection.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the process instance step in Salesforce."},
+			{Name: "process_instance_id", Type: proto.ColumnType_STRING, Description: "ID of the process instance the step belongs to."},
+			{Name: "step_status", Type: proto.ColumnType_STRING, Description: "The status of the step, for example Approved, Rejected, Removed, Pending or Started."},
+			{Name: "actor_id", Type: proto.ColumnType_STRING, Description: "ID of the user who acted on the step."},
+			{Name: "original_actor_id", Type: proto.ColumnType_STRING, Description: "ID of the user who was originally assigned the step."},
+
+			// Other columns
+			{Name: "comments", Type: proto.ColumnType_STRING, Description: "The comments entered by the actor."},
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the step."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the step was completed."},
+			{Name: "elapsed_time_in_days", Type: proto.ColumnType_DOUBLE, Description: "The time in days that the step took."},
+			{Name: "step_node_id", Type: proto.ColumnType_STRING, Description: "ID of the approval step node in the approval process definition."},
+		}),
+	}
+}
@@ -0,0 +1,37 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceProcessInstanceWorkitem(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "ProcessInstanceWorkitem"
+	return &plugin.Table{
+		Name:        "salesforce_process_instance_workitem",
+		Description: "Represents a pending approval request that is waiting for an actor.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the work item in Salesforce."},
+			{Name: "process_instance_id", Type: proto.ColumnType_STRING, Description: "ID of the process instance the work item belongs to."},
+			{Name: "actor_id", Type: proto.ColumnType_STRING, Description: "ID of the user or queue that is currently assigned the approval request."},
+			{Name: "original_actor_id", Type: proto.ColumnType_STRING, Description: "ID of the user or queue that was originally assigned the approval request."},
+
+			// Other columns
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the work item."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the approval request was assigned."},
+			{Name: "elapsed_time_in_days", Type: proto.ColumnType_DOUBLE, Description: "The time in days since the approval request was assigned."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the work item has been moved to the Recycle Bin (true) or not (false)."},
+		}),
+	}
+}
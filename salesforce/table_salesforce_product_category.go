@@ -0,0 +1,152 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// categoryPathDepth is the number of levels of the category path read through
+// the ParentCategory relationship. SOQL follows at most 5 relationships, so
+// deeper categories get a path starting with "...".
+const categoryPathDepth = 5
+
+var productCategoryQuery = fmt.Sprintf("SELECT Id, CatalogId, Catalog.Name, ParentCategoryId, Description, IsNavigational, SortOrder, SystemModstamp, %s FROM ProductCategory", strings.Join(categoryPathFields(""), ", "))
+
+type productCategoryRow struct {
+	ID               string
+	Name             string
+	CatalogID        string
+	CatalogName      string
+	ParentCategoryID string
+	CategoryPath     string
+	Description      string
+	IsNavigational   bool
+	SortOrder        *int64
+	SystemModstamp   string
+}
+
+func SalesforceProductCategory(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_product_category",
+		Description: "Represents a category of a commerce product catalog, with its path from the root category.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceProductCategories,
+			KeyColumns: plugin.OptionalColumns([]string{"id", "catalog_id", "parent_category_id"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the category.", Transform: transform.FromField("ID")},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the category.", Transform: transform.FromField("Name")},
+			{Name: "catalog_id", Type: proto.ColumnType_STRING, Description: "The ID of the catalog of the category.", Transform: transform.FromField("CatalogID")},
+			{Name: "catalog_name", Type: proto.ColumnType_STRING, Description: "The name of the catalog of the category.", Transform: transform.FromField("CatalogName")},
+			{Name: "parent_category_id", Type: proto.ColumnType_STRING, Description: "The ID of the parent category, or null for a root category.", Transform: transform.FromField("ParentCategoryID").NullIfZero()},
+			{Name: "category_path", Type: proto.ColumnType_STRING, Description: "The names of the categories from the root category to this one, separated by \" > \", e.g. \"Apparel > Shoes > Running\".", Transform: transform.FromField("CategoryPath")},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the category.", Transform: transform.FromField("Description")},
+			{Name: "is_navigational", Type: proto.ColumnType_BOOL, Description: "Indicates whether the category is shown in the navigation menu of the store.", Transform: transform.FromField("IsNavigational")},
+			{Name: "sort_order", Type: proto.ColumnType_INT, Description: "The position of the category among its sibling categories.", Transform: transform.FromField("SortOrder")},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the category was last modified by a user or by an automated process.", Transform: transform.FromField("SystemModstamp").NullIfZero()},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceProductCategories(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_product_category.listSalesforceProductCategories", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_product_category.listSalesforceProductCategories: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	query := productCategoryQuery
+	if condition := buildProductCategoryCondition(d.EqualsQualString("id"), d.EqualsQualString("catalog_id"), d.EqualsQualString("parent_category_id")); condition != "" {
+		query = fmt.Sprintf("%s WHERE %s", query, condition)
+	}
+
+	_, err = streamQueryRecords(ctx, d, client, "ProductCategory", query, func(record map[string]interface{}) bool {
+		d.StreamListItem(ctx, mapProductCategoryRecord(record))
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_product_category.listSalesforceProductCategories", "query error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// buildProductCategoryCondition returns the SOQL WHERE condition for the
+// optional id, catalog_id and parent_category_id quals.
+func buildProductCategoryCondition(id, catalogID, parentCategoryID string) string {
+	filters := []string{}
+	if id != "" {
+		filters = append(filters, fmt.Sprintf("Id = '%s'", escapeSOQLString(id)))
+	}
+	if catalogID != "" {
+		filters = append(filters, fmt.Sprintf("CatalogId = '%s'", escapeSOQLString(catalogID)))
+	}
+	if parentCategoryID != "" {
+		filters = append(filters, fmt.Sprintf("ParentCategoryId = '%s'", escapeSOQLString(parentCategoryID)))
+	}
+	return strings.Join(filters, " AND ")
+}
+
+// mapProductCategoryRecord flattens a ProductCategory record, including the
+// names of its catalog and parent categories, into a productCategoryRow.
+func mapProductCategoryRecord(record map[string]interface{}) productCategoryRow {
+	row := productCategoryRow{}
+	row.ID, _ = record["Id"].(string)
+	row.Name, _ = record["Name"].(string)
+	row.CatalogID, _ = record["CatalogId"].(string)
+	row.ParentCategoryID, _ = record["ParentCategoryId"].(string)
+	row.Description, _ = record["Description"].(string)
+	row.IsNavigational, _ = record["IsNavigational"].(bool)
+	row.SystemModstamp, _ = record["SystemModstamp"].(string)
+	if sortOrder, ok := record["SortOrder"].(float64); ok {
+		value := int64(sortOrder)
+		row.SortOrder = &value
+	}
+	if catalog, ok := record["Catalog"].(map[string]interface{}); ok {
+		row.CatalogName, _ = catalog["Name"].(string)
+	}
+	row.CategoryPath = categoryPath(record)
+	return row
+}
+
+// categoryPathFields returns the fields to select for the category path of the
+// ProductCategory at prefix, e.g. "ProductCategory." from a
+// ProductCategoryProduct: its name, the names of its ancestors up to
+// categoryPathDepth levels, and the parent of the last one, which tells
+// whether the path is complete.
+func categoryPathFields(prefix string) []string {
+	fields := []string{}
+	for level := 0; level < categoryPathDepth; level++ {
+		fields = append(fields, prefix+strings.Repeat("ParentCategory.", level)+"Name")
+	}
+	return append(fields, prefix+strings.Repeat("ParentCategory.", categoryPathDepth-1)+"ParentCategoryId")
+}
+
+// categoryPath joins the names of category and its ancestors, selected with
+// categoryPathFields, from the root category down. The path starts with "..."
+// if the category is nested deeper than categoryPathDepth levels.
+func categoryPath(category map[string]interface{}) string {
+	names := []string{}
+	for level := 0; level < categoryPathDepth && category != nil; level++ {
+		name, _ := category["Name"].(string)
+		names = append([]string{name}, names...)
+		if parentID, _ := category["ParentCategoryId"].(string); level == categoryPathDepth-1 && parentID != "" {
+			names = append([]string{"..."}, names...)
+		}
+		category, _ = category["ParentCategory"].(map[string]interface{})
+	}
+	return strings.Join(names, " > ")
+}
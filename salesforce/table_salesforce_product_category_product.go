@@ -0,0 +1,131 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+var productCategoryProductQuery = fmt.Sprintf("SELECT Id, ProductId, Product.Name, Product.ProductCode, Product.Family, Product.IsActive, ProductCategoryId, CatalogId, IsPrimaryCategory, SystemModstamp, %s FROM ProductCategoryProduct", strings.Join(categoryPathFields("ProductCategory."), ", "))
+
+type productCategoryProductRow struct {
+	ID                string
+	ProductID         string
+	ProductName       string
+	ProductCode       string
+	ProductFamily     string
+	ProductIsActive   bool
+	ProductCategoryID string
+	CategoryName      string
+	CategoryPath      string
+	CatalogID         string
+	IsPrimaryCategory bool
+	SystemModstamp    string
+}
+
+func SalesforceProductCategoryProduct(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_product_category_product",
+		Description: "Represents a product assigned to a category of a commerce product catalog, with the product code, family and category path.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceProductCategoryProducts,
+			KeyColumns: plugin.OptionalColumns([]string{"product_id", "product_code", "product_family", "product_category_id", "catalog_id"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the product category assignment.", Transform: transform.FromField("ID")},
+			{Name: "product_id", Type: proto.ColumnType_STRING, Description: "The ID of the product.", Transform: transform.FromField("ProductID")},
+			{Name: "product_name", Type: proto.ColumnType_STRING, Description: "The name of the product.", Transform: transform.FromField("ProductName")},
+			{Name: "product_code", Type: proto.ColumnType_STRING, Description: "The internal code or product number of the product.", Transform: transform.FromField("ProductCode").NullIfZero()},
+			{Name: "product_family", Type: proto.ColumnType_STRING, Description: "The product family of the product, such as Hardware or Software.", Transform: transform.FromField("ProductFamily").NullIfZero()},
+			{Name: "product_is_active", Type: proto.ColumnType_BOOL, Description: "Indicates whether the product is active and can be added to price books, opportunities and quotes.", Transform: transform.FromField("ProductIsActive")},
+			{Name: "product_category_id", Type: proto.ColumnType_STRING, Description: "The ID of the category.", Transform: transform.FromField("ProductCategoryID")},
+			{Name: "category_name", Type: proto.ColumnType_STRING, Description: "The name of the category.", Transform: transform.FromField("CategoryName")},
+			{Name: "category_path", Type: proto.ColumnType_STRING, Description: "The names of the categories from the root category to the category of the product, separated by \" > \", e.g. \"Apparel > Shoes > Running\".", Transform: transform.FromField("CategoryPath")},
+			{Name: "catalog_id", Type: proto.ColumnType_STRING, Description: "The ID of the catalog of the category.", Transform: transform.FromField("CatalogID")},
+			{Name: "is_primary_category", Type: proto.ColumnType_BOOL, Description: "Indicates whether the category is the primary category of the product in the catalog.", Transform: transform.FromField("IsPrimaryCategory")},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the assignment was last modified by a user or by an automated process.", Transform: transform.FromField("SystemModstamp").NullIfZero()},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceProductCategoryProducts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_product_category_product.listSalesforceProductCategoryProducts", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_product_category_product.listSalesforceProductCategoryProducts: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	query := productCategoryProductQuery
+	if condition := buildProductCategoryProductCondition(d.EqualsQualString("product_id"), d.EqualsQualString("product_code"), d.EqualsQualString("product_family"), d.EqualsQualString("product_category_id"), d.EqualsQualString("catalog_id")); condition != "" {
+		query = fmt.Sprintf("%s WHERE %s", query, condition)
+	}
+
+	_, err = streamQueryRecords(ctx, d, client, "ProductCategoryProduct", query, func(record map[string]interface{}) bool {
+		d.StreamListItem(ctx, mapProductCategoryProductRecord(record))
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_product_category_product.listSalesforceProductCategoryProducts", "query error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// buildProductCategoryProductCondition returns the SOQL WHERE condition for
+// the optional product_id, product_code, product_family, product_category_id
+// and catalog_id quals.
+func buildProductCategoryProductCondition(productID, productCode, productFamily, productCategoryID, catalogID string) string {
+	filters := []string{}
+	if productID != "" {
+		filters = append(filters, fmt.Sprintf("ProductId = '%s'", escapeSOQLString(productID)))
+	}
+	if productCode != "" {
+		filters = append(filters, fmt.Sprintf("Product.ProductCode = '%s'", escapeSOQLString(productCode)))
+	}
+	if productFamily != "" {
+		filters = append(filters, fmt.Sprintf("Product.Family = '%s'", escapeSOQLString(productFamily)))
+	}
+	if productCategoryID != "" {
+		filters = append(filters, fmt.Sprintf("ProductCategoryId = '%s'", escapeSOQLString(productCategoryID)))
+	}
+	if catalogID != "" {
+		filters = append(filters, fmt.Sprintf("CatalogId = '%s'", escapeSOQLString(catalogID)))
+	}
+	return strings.Join(filters, " AND ")
+}
+
+// mapProductCategoryProductRecord flattens a ProductCategoryProduct record,
+// including the Product and ProductCategory relationship fields, into a
+// productCategoryProductRow.
+func mapProductCategoryProductRecord(record map[string]interface{}) productCategoryProductRow {
+	row := productCategoryProductRow{}
+	row.ID, _ = record["Id"].(string)
+	row.ProductID, _ = record["ProductId"].(string)
+	row.ProductCategoryID, _ = record["ProductCategoryId"].(string)
+	row.CatalogID, _ = record["CatalogId"].(string)
+	row.IsPrimaryCategory, _ = record["IsPrimaryCategory"].(bool)
+	row.SystemModstamp, _ = record["SystemModstamp"].(string)
+	if product, ok := record["Product"].(map[string]interface{}); ok {
+		row.ProductName, _ = product["Name"].(string)
+		row.ProductCode, _ = product["ProductCode"].(string)
+		row.ProductFamily, _ = product["Family"].(string)
+		row.ProductIsActive, _ = product["IsActive"].(bool)
+	}
+	if category, ok := record["ProductCategory"].(map[string]interface{}); ok {
+		row.CategoryName, _ = category["Name"].(string)
+		row.CategoryPath = categoryPath(category)
+	}
+	return row
+}
@@ -0,0 +1,78 @@
+package salesforce
+
+import (
+	"testing"
+)
+
+func TestMapProductCategoryProductRecord(t *testing.T) {
+	t.Run("flattens product and category fields", func(t *testing.T) {
+		record := map[string]interface{}{
+			"attributes":        map[string]interface{}{"type": "ProductCategoryProduct"},
+			"Id":                "0ZHxx0000000001",
+			"ProductId":         "01txx0000000001",
+			"ProductCategoryId": "0ZGxx0000000003",
+			"CatalogId":         "0ZSxx0000000001",
+			"IsPrimaryCategory": true,
+			"SystemModstamp":    "2024-06-01T08:00:00.000+0000",
+			"Product": map[string]interface{}{
+				"attributes":  map[string]interface{}{"type": "Product2"},
+				"Name":        "Trail Runner",
+				"ProductCode": "TR-100",
+				"Family":      "Footwear",
+				"IsActive":    true,
+			},
+			"ProductCategory": categoryRecord("Running", "Shoes", "Apparel"),
+		}
+		got := mapProductCategoryProductRecord(record)
+		expected := productCategoryProductRow{
+			ID:                "0ZHxx0000000001",
+			ProductID:         "01txx0000000001",
+			ProductName:       "Trail Runner",
+			ProductCode:       "TR-100",
+			ProductFamily:     "Footwear",
+			ProductIsActive:   true,
+			ProductCategoryID: "0ZGxx0000000003",
+			CategoryName:      "Running",
+			CategoryPath:      "Apparel > Shoes > Running",
+			CatalogID:         "0ZSxx0000000001",
+			IsPrimaryCategory: true,
+			SystemModstamp:    "2024-06-01T08:00:00.000+0000",
+		}
+		if got != expected {
+			t.Errorf("got %+v, want %+v", got, expected)
+		}
+	})
+
+	t.Run("missing relationships", func(t *testing.T) {
+		got := mapProductCategoryProductRecord(map[string]interface{}{"Id": "0ZHxx0000000001", "Product": nil, "ProductCategory": nil})
+		if got.ID != "0ZHxx0000000001" || got.ProductCode != "" || got.ProductIsActive || got.CategoryPath != "" {
+			t.Errorf("got %+v, want only ID set", got)
+		}
+	})
+}
+
+func TestBuildProductCategoryProductCondition(t *testing.T) {
+	tests := []struct {
+		name              string
+		productID         string
+		productCode       string
+		productFamily     string
+		productCategoryID string
+		catalogID         string
+		expected          string
+	}{
+		{"no quals", "", "", "", "", "", ""},
+		{"family only", "", "", "Footwear", "", "", "Product.Family = 'Footwear'"},
+		{"all quals", "01txx", "TR-100", "Footwear", "0ZGxx", "0ZSxx", "ProductId = '01txx' AND Product.ProductCode = 'TR-100' AND Product.Family = 'Footwear' AND ProductCategoryId = '0ZGxx' AND CatalogId = '0ZSxx'"},
+		{"quote is escaped", "", "TR' OR Name != '", "", "", "", `Product.ProductCode = 'TR\' OR Name != \''`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildProductCategoryProductCondition(tt.productID, tt.productCode, tt.productFamily, tt.productCategoryID, tt.catalogID)
+			if got != tt.expected {
+				t.Errorf("buildProductCategoryProductCondition() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
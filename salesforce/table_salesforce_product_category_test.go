@@ -0,0 +1,101 @@
+package salesforce
+
+import (
+	"strings"
+	"testing"
+)
+
+// categoryRecord returns a ProductCategory record named name whose ancestors
+// are named parents, from the parent category up.
+func categoryRecord(name string, parents ...string) map[string]interface{} {
+	record := map[string]interface{}{"attributes": map[string]interface{}{"type": "ProductCategory"}, "Name": name, "ParentCategory": nil}
+	if len(parents) > 0 {
+		record["ParentCategory"] = categoryRecord(parents[0], parents[1:]...)
+		record["ParentCategoryId"] = "0ZGxx" + parents[0]
+	}
+	return record
+}
+
+func TestCategoryPathFields(t *testing.T) {
+	got := strings.Join(categoryPathFields("ProductCategory."), ", ")
+	expected := "ProductCategory.Name, ProductCategory.ParentCategory.Name, ProductCategory.ParentCategory.ParentCategory.Name, " +
+		"ProductCategory.ParentCategory.ParentCategory.ParentCategory.Name, ProductCategory.ParentCategory.ParentCategory.ParentCategory.ParentCategory.Name, " +
+		"ProductCategory.ParentCategory.ParentCategory.ParentCategory.ParentCategory.ParentCategoryId"
+	if got != expected {
+		t.Errorf("categoryPathFields() = %q, want %q", got, expected)
+	}
+	if !strings.Contains(productCategoryQuery, " Name, ParentCategory.Name,") || strings.Count(productCategoryQuery, " Name,") != 1 {
+		t.Errorf("productCategoryQuery should select Name once: %q", productCategoryQuery)
+	}
+}
+
+func TestCategoryPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		category map[string]interface{}
+		expected string
+	}{
+		{"root category", categoryRecord("Apparel"), "Apparel"},
+		{"nested category", categoryRecord("Running", "Shoes", "Apparel"), "Apparel > Shoes > Running"},
+		{"deepest category read", categoryRecord("E", "D", "C", "B", "A"), "A > B > C > D > E"},
+		{"deeper than the depth read", categoryRecord("F", "E", "D", "C", "B", "A"), "... > B > C > D > E > F"},
+		{"no category", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := categoryPath(tt.category); got != tt.expected {
+				t.Errorf("categoryPath() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMapProductCategoryRecord(t *testing.T) {
+	t.Run("flattens catalog and parent category fields", func(t *testing.T) {
+		record := categoryRecord("Running", "Shoes", "Apparel")
+		record["Id"] = "0ZGxx0000000003"
+		record["CatalogId"] = "0ZSxx0000000001"
+		record["Catalog"] = map[string]interface{}{"attributes": map[string]interface{}{"type": "ProductCatalog"}, "Name": "Store Catalog"}
+		record["IsNavigational"] = true
+		record["SortOrder"] = float64(2)
+		got := mapProductCategoryRecord(record)
+		if got.ID != "0ZGxx0000000003" || got.Name != "Running" || got.CatalogID != "0ZSxx0000000001" || got.CatalogName != "Store Catalog" || got.ParentCategoryID != "0ZGxxShoes" {
+			t.Errorf("got %+v", got)
+		}
+		if got.CategoryPath != "Apparel > Shoes > Running" || !got.IsNavigational || got.SortOrder == nil || *got.SortOrder != 2 {
+			t.Errorf("got %+v", got)
+		}
+	})
+
+	t.Run("null sort order and catalog", func(t *testing.T) {
+		got := mapProductCategoryRecord(map[string]interface{}{"Id": "0ZGxx0000000001", "Name": "Apparel", "SortOrder": nil, "Catalog": nil})
+		if got.SortOrder != nil || got.CatalogName != "" || got.CategoryPath != "Apparel" {
+			t.Errorf("got %+v, want only ID, name and path set", got)
+		}
+	})
+}
+
+func TestBuildProductCategoryCondition(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               string
+		catalogID        string
+		parentCategoryID string
+		expected         string
+	}{
+		{"no quals", "", "", "", ""},
+		{"catalog only", "", "0ZSxx", "", "CatalogId = '0ZSxx'"},
+		{"all quals", "0ZGxx1", "0ZSxx", "0ZGxx2", "Id = '0ZGxx1' AND CatalogId = '0ZSxx' AND ParentCategoryId = '0ZGxx2'"},
+		{"quote is escaped", "", "0ZSxx' OR Name != '", "", `CatalogId = '0ZSxx\' OR Name != \''`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildProductCategoryCondition(tt.id, tt.catalogID, tt.parentCategoryID)
+			if got != tt.expected {
+				t.Errorf("buildProductCategoryCondition() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type queryRow struct {
+	Query  string
+	Result map[string]interface{}
+}
+
+func SalesforceQuery(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_query",
+		Description: "Runs an arbitrary SOQL query, such as a relationship or aggregate query, and returns each record as JSON.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceQuery,
+			KeyColumns: plugin.SingleColumn("query"),
+		},
+		Columns: []*plugin.Column{
+			{Name: "query", Type: proto.ColumnType_STRING, Description: "The SOQL query to run.", Transform: transform.FromField("Query")},
+			{Name: "result", Type: proto.ColumnType_JSON, Description: "The record returned by the query, as returned by the Salesforce query API.", Transform: transform.FromField("Result")},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceQuery(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	query := d.EqualsQualString("query")
+	if query == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_query.listSalesforceQuery", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_query.listSalesforceQuery: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	if err := checkAggregateQuery(ctx, d, client, query); err != nil {
+		plugin.Logger(ctx).Error("salesforce_query.listSalesforceQuery", "validation error", err)
+		return nil, err
+	}
+
+	stripAttributes := stripChildRecordAttributes(GetConfig(d.Connection))
+	// The queried object isn't known, so the connection-level retry policy is used
+	_, err = streamQueryRecords(ctx, d, client, "", query, func(record map[string]interface{}) bool {
+		if stripAttributes {
+			removeChildRecordAttributes(record)
+		}
+		d.StreamListItem(ctx, queryRow{Query: query, Result: record})
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_query.listSalesforceQuery", "query error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// stripChildRecordAttributes returns true if child_record_attributes is
+// "strip", i.e. the attributes of the records of child relationship
+// subqueries are removed from query results.
+func stripChildRecordAttributes(config salesforceConfig) bool {
+	return config.ChildRecordAttributes != nil && *config.ChildRecordAttributes == CHILD_RECORD_ATTRIBUTES_STRIP
+}
+
+// removeChildRecordAttributes removes the attributes of the records of the
+// child relationship subqueries of a record, such as its Contacts, and of
+// everything nested in them. A child relationship is a map holding a records
+// list; other maps are parent relationships, whose attributes are kept.
+func removeChildRecordAttributes(record map[string]interface{}) {
+	for _, value := range record {
+		nested, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		children, ok := nested["records"].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, child := range children {
+			if child, ok := child.(map[string]interface{}); ok {
+				removeRecordAttributes(child)
+			}
+		}
+	}
+}
+
+// removeRecordAttributes removes the attributes of a record and of its
+// parent and child relationships.
+func removeRecordAttributes(record map[string]interface{}) {
+	delete(record, "attributes")
+	removeChildRecordAttributes(record)
+	for _, value := range record {
+		if nested, ok := value.(map[string]interface{}); ok && nested["records"] == nil {
+			removeRecordAttributes(nested)
+		}
+	}
+}
+
+var (
+	soqlFromRegexp       = regexp.MustCompile(`(?i)\bfrom\s+(\w+)`)
+	soqlGroupByRegexp    = regexp.MustCompile(`(?is)\bgroup\s+by\s+(.*?)(?:\bhaving\b|\border\s+by\b|\blimit\b|\boffset\b|$)`)
+	soqlGroupingRegexp   = regexp.MustCompile(`(?i)\b(?:rollup|cube)\s*\(([^)]*)\)`)
+	soqlAggregateRegexp  = regexp.MustCompile(`(?i)\b(?:avg|count|count_distinct|max|min|sum)\s*\(\s*(\w+)\s*\)`)
+	soqlIdentifierRegexp = regexp.MustCompile(`^\w+$`)
+	soqlSubqueryRegexp   = regexp.MustCompile(`(?i)\(\s*select\b`)
+)
+
+// checkAggregateQuery describes the object of an aggregate query and checks
+// its fields can be grouped and aggregated, so the query fails with a clear
+// error instead of the API's MALFORMED_QUERY. Other queries aren't described.
+func checkAggregateQuery(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, query string) error {
+	objectName := aggregateQueryObject(query)
+	if objectName == "" {
+		return nil
+	}
+	fields, err := describeFields(describeSObject(ctx, d.ConnectionCache, client, GetConfig(d.Connection), objectName))
+	if err != nil {
+		// Leave the query for Salesforce to validate
+		plugin.Logger(ctx).Warn("salesforce_query.checkAggregateQuery", "msg", "unable to describe object, skipping validation", "object_name", objectName, "error", err)
+		return nil
+	}
+	return validateAggregateQuery(query, objectName, fields)
+}
+
+// aggregateQueryObject returns the object an aggregate query reads, or an
+// empty string if the query doesn't group or aggregate records. Queries with
+// subqueries are skipped, since their fields belong to other objects.
+func aggregateQueryObject(query string) string {
+	if soqlSubqueryRegexp.MatchString(query) {
+		return ""
+	}
+	if !soqlGroupByRegexp.MatchString(query) && !soqlAggregateRegexp.MatchString(query) {
+		return ""
+	}
+	match := soqlFromRegexp.FindStringSubmatch(query)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// validateAggregateQuery returns an error if the query groups by a field that
+// isn't groupable or aggregates one that isn't aggregatable. Only fields of
+// the queried object are checked; relationship paths, date functions and
+// unknown fields are left for Salesforce to validate.
+func validateAggregateQuery(query string, objectName string, fields []describeField) error {
+	byName := map[string]describeField{}
+	for _, field := range fields {
+		byName[strings.ToLower(field.Name)] = field
+	}
+
+	if match := soqlGroupByRegexp.FindStringSubmatch(query); match != nil {
+		groupBy := soqlGroupingRegexp.ReplaceAllString(match[1], "$1")
+		for _, name := range strings.Split(groupBy, ",") {
+			name = strings.TrimSpace(name)
+			if !soqlIdentifierRegexp.MatchString(name) {
+				continue
+			}
+			if field, ok := byName[strings.ToLower(name)]; ok && !field.Groupable {
+				return fmt.Errorf("field %s of %s can't be used in GROUP BY because it isn't groupable", field.Name, objectName)
+			}
+		}
+	}
+
+	for _, match := range soqlAggregateRegexp.FindAllStringSubmatch(query, -1) {
+		if field, ok := byName[strings.ToLower(match[1])]; ok && !field.Aggregatable {
+			return fmt.Errorf("field %s of %s can't be used in an aggregate function because it isn't aggregatable", field.Name, objectName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package salesforce
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func TestSalesforceQueryTable(t *testing.T) {
+	table := SalesforceQuery(contextWithLogger(&bytes.Buffer{}), salesforceConfig{})
+	keyColumns := table.List.KeyColumns
+	if len(keyColumns) != 1 || keyColumns[0].Name != "query" || keyColumns[0].Require != plugin.Required {
+		t.Errorf("key columns = %v, want a required query", keyColumns)
+	}
+	for _, col := range []string{"query", "result"} {
+		if !hasColumn(table.Columns, col) {
+			t.Errorf("missing column %q", col)
+		}
+	}
+}
+
+func TestSalesforceQuery_Paging(t *testing.T) {
+	query := "SELECT Name, (SELECT LastName FROM Contacts) FROM Account"
+	queries := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/services/data/v" + defaultAPIVersion + "/query":
+			queries = append(queries, r.URL.Query().Get("q"))
+			w.Write([]byte(`{"totalSize":2,"done":false,"nextRecordsUrl":"/services/data/v` + defaultAPIVersion + `/query/01g-1","records":[
+				{"attributes":{"type":"Account"},"Name":"Acme","Contacts":{"totalSize":1,"done":true,"records":[{"attributes":{"type":"Contact"},"LastName":"Doe"}]}}
+			]}`))
+		case "/services/data/v" + defaultAPIVersion + "/query/01g-1":
+			w.Write([]byte(`{"totalSize":2,"done":true,"records":[
+				{"attributes":{"type":"Account"},"Name":"Globex","Contacts":null}
+			]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	var buf bytes.Buffer
+	d := &plugin.QueryData{Connection: &plugin.Connection{Config: salesforceConfig{URL: stringPtr(server.URL), AccessToken: stringPtr("token")}}}
+	client, err := connect(contextWithLogger(&buf), d)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	rows := []queryRow{}
+	_, err = streamQueryRecords(contextWithLogger(&buf), d, client, "", query, func(record map[string]interface{}) bool {
+		rows = append(rows, queryRow{Query: query, Result: record})
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(queries) != 1 || queries[0] != query {
+		t.Errorf("queries = %q, want the SOQL sent unchanged", queries)
+	}
+	if len(rows) != 2 || rows[0].Result["Name"] != "Acme" || rows[1].Result["Name"] != "Globex" {
+		t.Fatalf("rows = %v, want Acme and Globex from both pages", rows)
+	}
+	contacts, ok := rows[0].Result["Contacts"].(map[string]interface{})
+	if !ok || contacts["totalSize"] != float64(1) {
+		t.Errorf("Contacts = %v, want the child records kept", rows[0].Result["Contacts"])
+	}
+}
+
+func TestRemoveChildRecordAttributes(t *testing.T) {
+	parse := func(t *testing.T, body string) map[string]interface{} {
+		t.Helper()
+		record := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(body), &record); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		return record
+	}
+
+	t.Run("strips child records and their relationships", func(t *testing.T) {
+		record := parse(t, `{
+			"attributes": {"type": "Account", "url": "/services/data/v62.0/sobjects/Account/001a"},
+			"Name": "Acme",
+			"Owner": {"attributes": {"type": "User"}, "Name": "Jane"},
+			"Contacts": {"totalSize": 2, "done": true, "records": [
+				{"attributes": {"type": "Contact"}, "LastName": "Doe", "ReportsTo": {"attributes": {"type": "Contact"}, "LastName": "Roe"}},
+				{"attributes": {"type": "Contact"}, "LastName": "Poe", "ReportsTo": null,
+					"Cases": {"totalSize": 1, "done": true, "records": [{"attributes": {"type": "Case"}, "Subject": "Login"}]}}
+			]},
+			"Opportunities": null
+		}`)
+		removeChildRecordAttributes(record)
+		expected := parse(t, `{
+			"attributes": {"type": "Account", "url": "/services/data/v62.0/sobjects/Account/001a"},
+			"Name": "Acme",
+			"Owner": {"attributes": {"type": "User"}, "Name": "Jane"},
+			"Contacts": {"totalSize": 2, "done": true, "records": [
+				{"LastName": "Doe", "ReportsTo": {"LastName": "Roe"}},
+				{"LastName": "Poe", "ReportsTo": null,
+					"Cases": {"totalSize": 1, "done": true, "records": [{"Subject": "Login"}]}}
+			]},
+			"Opportunities": null
+		}`)
+		if !reflect.DeepEqual(record, expected) {
+			t.Errorf("got %v, want %v", record, expected)
+		}
+	})
+
+	t.Run("empty child relationship", func(t *testing.T) {
+		record := parse(t, `{"attributes": {"type": "Account"}, "Contacts": {"totalSize": 0, "done": true, "records": []}}`)
+		removeChildRecordAttributes(record)
+		if _, ok := record["attributes"]; !ok {
+			t.Errorf("got %v, want the attributes of the record kept", record)
+		}
+	})
+}
+
+func TestStripChildRecordAttributes(t *testing.T) {
+	keep, strip := CHILD_RECORD_ATTRIBUTES_KEEP, CHILD_RECORD_ATTRIBUTES_STRIP
+	tests := []struct {
+		name     string
+		value    *ChildRecordAttributesEnum
+		expected bool
+	}{
+		{"unset", nil, false},
+		{"keep", &keep, false},
+		{"strip", &strip, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stripChildRecordAttributes(salesforceConfig{ChildRecordAttributes: tt.value}); got != tt.expected {
+				t.Errorf("stripChildRecordAttributes() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAggregateQueryObject(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"SELECT StageName, COUNT(Id) FROM Opportunity GROUP BY StageName", "Opportunity"},
+		{"select sum(Amount) from Opportunity", "Opportunity"},
+		{"SELECT COUNT() FROM Account GROUP BY Industry", "Account"},
+		{"SELECT Id, Name FROM Account", ""},
+		{"SELECT Id, FromAddress FROM EmailMessage", ""},
+		{"SELECT Name, (SELECT COUNT(Id) FROM Contacts) FROM Account", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if got := aggregateQueryObject(tt.query); got != tt.expected {
+				t.Errorf("aggregateQueryObject() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateAggregateQuery(t *testing.T) {
+	fields := []describeField{
+		{Name: "Id", Groupable: true, Aggregatable: true},
+		{Name: "StageName", Groupable: true, Aggregatable: true},
+		{Name: "Amount", Groupable: true, Aggregatable: true},
+		{Name: "CreatedDate", Groupable: false, Aggregatable: true},
+		{Name: "Description", Groupable: false, Aggregatable: false},
+	}
+	tests := []struct {
+		name  string
+		query string
+		err   string
+	}{
+		{"groupable fields", "SELECT StageName, SUM(Amount) FROM Opportunity GROUP BY StageName ORDER BY StageName", ""},
+		{"rollup", "SELECT StageName, Amount, COUNT(Id) FROM Opportunity GROUP BY ROLLUP(StageName, Amount) LIMIT 10", ""},
+		{"date function", "SELECT CALENDAR_YEAR(CreatedDate), COUNT(Id) FROM Opportunity GROUP BY CALENDAR_YEAR(CreatedDate)", ""},
+		{"unknown field left to salesforce", "SELECT Foo__c, COUNT(Id) FROM Opportunity GROUP BY Foo__c", ""},
+		{"not groupable", "SELECT CreatedDate, COUNT(Id) FROM Opportunity GROUP BY CreatedDate", "field CreatedDate of Opportunity can't be used in GROUP BY because it isn't groupable"},
+		{"not groupable in cube", "SELECT COUNT(Id) FROM Opportunity GROUP BY CUBE(StageName, description) HAVING COUNT(Id) > 1", "field Description of Opportunity can't be used in GROUP BY because it isn't groupable"},
+		{"not aggregatable", "SELECT MAX(Description) FROM Opportunity", "field Description of Opportunity can't be used in an aggregate function because it isn't aggregatable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAggregateQuery(tt.query, "Opportunity", fields)
+			if tt.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceQuote(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "Quote"
+	return &plugin.Table{
+		Name:        "salesforce_quote",
+		Description: "Represents a quote, which is a record showing proposed prices for products and services, created from an opportunity.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the quote in Salesforce."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "Name of the quote."},
+			{Name: "quote_number", Type: proto.ColumnType_STRING, Description: "Automatically generated number that identifies the quote."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "Status of the quote, such as Draft, Needs Review, Presented, Accepted or Denied."},
+			{Name: "opportunity_id", Type: proto.ColumnType_STRING, Description: "ID of the opportunity the quote was created from."},
+			{Name: "grand_total", Type: proto.ColumnType_DOUBLE, Description: "Total price of the quote plus shipping and taxes."},
+			{Name: "total_price", Type: proto.ColumnType_DOUBLE, Description: "Total of the quote line items after discounts, before shipping and taxes."},
+
+			// Other columns
+			{Name: "account_id", Type: proto.ColumnType_STRING, Description: "ID of the account of the quote's opportunity."},
+			{Name: "contact_id", Type: proto.ColumnType_STRING, Description: "ID of the contact the quote is for."},
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the quote."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the creation of the quote."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "Description of the quote."},
+			{Name: "discount", Type: proto.ColumnType_DOUBLE, Description: "Average discount of the quote line items, as a percentage."},
+			{Name: "expiration_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date after which the quote is no longer valid."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the quote has been moved to the Recycle Bin (true) or not (false)."},
+			{Name: "is_syncing", Type: proto.ColumnType_BOOL, Description: "Indicates whether the quote's line items are synced with the products of its opportunity (true) or not (false)."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who most recently changed the quote."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the most recent change to the quote."},
+			{Name: "line_item_count", Type: proto.ColumnType_INT, Description: "Number of line items of the quote."},
+			{Name: "owner_id", Type: proto.ColumnType_STRING, Description: "ID of the user who owns the quote."},
+			{Name: "pricebook_2_id", Type: proto.ColumnType_STRING, Description: "ID of the price book of the quote."},
+			{Name: "shipping_handling", Type: proto.ColumnType_DOUBLE, Description: "Shipping and handling charges of the quote."},
+			{Name: "subtotal", Type: proto.ColumnType_DOUBLE, Description: "Total of the quote line items before discounts."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the quote was last modified by a user or by an automated process."},
+			{Name: "tax", Type: proto.ColumnType_DOUBLE, Description: "Total taxes of the quote."},
+		}),
+	}
+}
@@ -0,0 +1,52 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceQuoteLineItem(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "QuoteLineItem"
+	return &plugin.Table{
+		Name:        "salesforce_quote_line_item",
+		Description: "Represents a product on a quote, with its quantity and price.",
+		List: &plugin.ListConfig{
+			Hydrate: listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			// Line items are listed one quote at a time, like the related list
+			// of the quote
+			KeyColumns: requireKeyColumn(dm.keyColumns, checkColumnNameScheme(config, dm.cols, "quote_id")),
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the quote line item in Salesforce."},
+			{Name: "quote_id", Type: proto.ColumnType_STRING, Description: "ID of the quote of the line item."},
+			{Name: "line_number", Type: proto.ColumnType_STRING, Description: "Automatically generated number that identifies the line item."},
+			{Name: "product_2_id", Type: proto.ColumnType_STRING, Description: "ID of the product of the line item."},
+			{Name: "quantity", Type: proto.ColumnType_DOUBLE, Description: "Number of units of the product."},
+			{Name: "unit_price", Type: proto.ColumnType_DOUBLE, Description: "Sales price of one unit of the product."},
+			{Name: "total_price", Type: proto.ColumnType_DOUBLE, Description: "Price of the line item after discount, i.e. quantity times unit_price less the discount."},
+
+			// Other columns
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the line item."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the creation of the line item."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "Description of the line item."},
+			{Name: "discount", Type: proto.ColumnType_DOUBLE, Description: "Discount of the line item, as a percentage."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the line item has been moved to the Recycle Bin (true) or not (false)."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who most recently changed the line item."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the most recent change to the line item."},
+			{Name: "list_price", Type: proto.ColumnType_DOUBLE, Description: "Price of the product in the price book of the quote."},
+			{Name: "opportunity_line_item_id", Type: proto.ColumnType_STRING, Description: "ID of the opportunity product the line item is synced with."},
+			{Name: "pricebook_entry_id", Type: proto.ColumnType_STRING, Description: "ID of the price book entry of the product."},
+			{Name: "service_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date when the product is to be delivered or the service performed."},
+			{Name: "sort_order", Type: proto.ColumnType_INT, Description: "Position of the line item on the quote."},
+			{Name: "subtotal", Type: proto.ColumnType_DOUBLE, Description: "Price of the line item before discount, i.e. quantity times unit_price."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the line item was last modified by a user or by an automated process."},
+		}),
+	}
+}
@@ -0,0 +1,41 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceRecentlyViewed(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "RecentlyViewed"
+	return &plugin.Table{
+		Name:        "salesforce_recently_viewed",
+		Description: "Represents records that the connection's user has recently viewed or referenced.",
+		// RecentlyViewed can be queried but not retrieved by ID, so there is no
+		// Get
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "ID of the recently viewed record."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "Name of the recently viewed record."},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "Object of the recently viewed record, such as Account or Opportunity."},
+			{Name: "last_viewed_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the user last viewed the record."},
+			{Name: "last_referenced_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the user last viewed a record related to the record."},
+
+			// Other columns
+			{Name: "alias", Type: proto.ColumnType_STRING, Description: "Alias of the recently viewed user, if the record is a user."},
+			{Name: "email", Type: proto.ColumnType_STRING, Description: "Email address of the recently viewed contact, lead or user."},
+			{Name: "first_name", Type: proto.ColumnType_STRING, Description: "First name of the recently viewed contact, lead or user."},
+			{Name: "is_active", Type: proto.ColumnType_BOOL, Description: "Indicates whether the recently viewed record, such as a user or product, is active (true) or not (false)."},
+			{Name: "last_name", Type: proto.ColumnType_STRING, Description: "Last name of the recently viewed contact, lead or user."},
+			{Name: "phone", Type: proto.ColumnType_STRING, Description: "Phone number of the recently viewed record."},
+			{Name: "profile_id", Type: proto.ColumnType_STRING, Description: "ID of the profile of the recently viewed user, if the record is a user."},
+			{Name: "title", Type: proto.ColumnType_STRING, Description: "Title of the recently viewed contact, lead or user."},
+			{Name: "user_role_id", Type: proto.ColumnType_STRING, Description: "ID of the role of the recently viewed user, if the record is a user."},
+		}),
+	}
+}
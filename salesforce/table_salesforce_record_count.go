@@ -0,0 +1,92 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type recordCountRow struct {
+	ObjectName  string `json:"name"`
+	RecordCount int    `json:"count"`
+}
+
+func SalesforceRecordCount(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_record_count",
+		Description: "Approximate number of records of each Salesforce object, as returned by the recordCount resource.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceRecordCounts,
+			KeyColumns: plugin.OptionalColumns([]string{"object_name"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "object_name", Type: proto.ColumnType_STRING, Description: "The API name of the Salesforce object.", Transform: transform.FromField("ObjectName")},
+			{Name: "record_count", Type: proto.ColumnType_INT, Description: "Approximate number of records of the object. Salesforce refreshes the counts periodically, so recent changes may not be included.", Transform: transform.FromField("RecordCount")},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceRecordCounts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_record_count.listSalesforceRecordCounts", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_record_count.listSalesforceRecordCounts: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	// Without sObjects, counts of every object with records are returned
+	path := fmt.Sprintf("services/data/v%s/limits/recordCount", getAPIVersion(GetConfig(d.Connection)))
+	if name := d.EqualsQualString("object_name"); name != "" {
+		path += "?sObjects=" + url.QueryEscape(name)
+	}
+	_, data, err := restGetWithRetry(ctx, d, client, path)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_record_count.listSalesforceRecordCounts", "request error", err)
+		return nil, err
+	}
+
+	rows, err := parseRecordCounts(data)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_record_count.listSalesforceRecordCounts", "response decoding error", err)
+		return nil, err
+	}
+	for _, row := range rows {
+		d.StreamListItem(ctx, row)
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// parseRecordCounts returns the object counts of a recordCount response, in
+// the order Salesforce returns them.
+// Ref: https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_record_count.htm
+func parseRecordCounts(data []byte) ([]recordCountRow, error) {
+	var result struct {
+		SObjects []recordCountRow `json:"sObjects"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse recordCount response: %v", err)
+	}
+	rows := []recordCountRow{}
+	for _, row := range result.SObjects {
+		if row.ObjectName == "" {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
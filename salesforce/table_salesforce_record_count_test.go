@@ -0,0 +1,45 @@
+package salesforce
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseRecordCounts(t *testing.T) {
+	t.Run("counts in response order", func(t *testing.T) {
+		rows, err := parseRecordCounts([]byte(`{"sObjects":[{"count":3,"name":"Account"},{"count":10,"name":"Contact"},{"count":0,"name":"Invoice__c"}]}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := []recordCountRow{{"Account", 3}, {"Contact", 10}, {"Invoice__c", 0}}
+		if !slices.Equal(rows, expected) {
+			t.Errorf("rows = %+v, want %+v", rows, expected)
+		}
+	})
+
+	t.Run("no objects", func(t *testing.T) {
+		rows, err := parseRecordCounts([]byte(`{"sObjects":[]}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(rows) != 0 {
+			t.Errorf("rows = %+v, want none", rows)
+		}
+	})
+
+	t.Run("entries without a name are skipped", func(t *testing.T) {
+		rows, err := parseRecordCounts([]byte(`{"sObjects":[{"count":5},{"count":1,"name":"Lead"}]}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expected := []recordCountRow{{"Lead", 1}}; !slices.Equal(rows, expected) {
+			t.Errorf("rows = %+v, want %+v", rows, expected)
+		}
+	})
+
+	t.Run("invalid response", func(t *testing.T) {
+		if _, err := parseRecordCounts([]byte(`[{"errorCode":"NOT_FOUND"}]`)); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Notification sources for Lightning report and dashboard subscriptions in the
+// Analytics Notifications API.
+var reportSubscriptionSources = []string{"lightningReportSubscribe", "lightningDashboardSubscribe"}
+
+type reportSubscriptionRow struct {
+	ID               string
+	Name             string
+	Source           string
+	Active           bool
+	OwnerID          string
+	OwnerName        string
+	RecordID         string
+	Frequency        string
+	Schedule         map[string]interface{}
+	Recipients       []reportSubscriptionRecipient
+	CreatedDate      string
+	LastModifiedDate string
+}
+
+type reportSubscriptionRecipient struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Type        string `json:"type"`
+}
+
+// analyticsNotification is an entry of the Analytics Notifications API response.
+// Ref: https://developer.salesforce.com/docs/atlas.en-us.api_analytics.meta/api_analytics/analytics_api_notifications_reference_notifications.htm
+type analyticsNotification struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Source           string `json:"source"`
+	Active           bool   `json:"active"`
+	RecordID         string `json:"recordId"`
+	CreatedDate      string `json:"createdDate"`
+	LastModifiedDate string `json:"lastModifiedDate"`
+	Owner            struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"owner"`
+	Schedule struct {
+		Frequency string                 `json:"frequency"`
+		Details   map[string]interface{} `json:"details"`
+	} `json:"schedule"`
+	Thresholds []struct {
+		Actions []struct {
+			Type          string `json:"type"`
+			Configuration struct {
+				Recipients []reportSubscriptionRecipient `json:"recipients"`
+			} `json:"configuration"`
+		} `json:"actions"`
+	} `json:"thresholds"`
+}
+
+func SalesforceReportSubscription(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_report_subscription",
+		Description: "Represents a scheduled subscription to a Lightning report or dashboard refresh.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceReportSubscriptions,
+			KeyColumns: plugin.OptionalColumns([]string{"source", "record_id"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the subscription.", Transform: transform.FromField("ID")},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the subscription.", Transform: transform.FromField("Name")},
+			{Name: "source", Type: proto.ColumnType_STRING, Description: "The type of subscription, either lightningReportSubscribe or lightningDashboardSubscribe.", Transform: transform.FromField("Source")},
+			{Name: "active", Type: proto.ColumnType_BOOL, Description: "True if the subscription is active.", Transform: transform.FromField("Active")},
+			{Name: "owner_id", Type: proto.ColumnType_STRING, Description: "The ID of the user who owns the subscription.", Transform: transform.FromField("OwnerID")},
+			{Name: "owner_name", Type: proto.ColumnType_STRING, Description: "The name of the user who owns the subscription.", Transform: transform.FromField("OwnerName")},
+			{Name: "record_id", Type: proto.ColumnType_STRING, Description: "The ID of the report or dashboard the subscription targets.", Transform: transform.FromField("RecordID")},
+			{Name: "frequency", Type: proto.ColumnType_STRING, Description: "How often the subscription runs, for example daily, weekly or monthly.", Transform: transform.FromField("Frequency")},
+			{Name: "schedule", Type: proto.ColumnType_JSON, Description: "The schedule details, such as the time of day and days of the week or month.", Transform: transform.FromField("Schedule")},
+			{Name: "recipients", Type: proto.ColumnType_JSON, Description: "The users, groups and roles that receive the subscription email.", Transform: transform.FromField("Recipients")},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the subscription was created.", Transform: transform.FromField("CreatedDate").NullIfZero()},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the subscription was last modified.", Transform: transform.FromField("LastModifiedDate").NullIfZero()},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceReportSubscriptions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_report_subscription.listSalesforceReportSubscriptions", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_report_subscription.listSalesforceReportSubscriptions: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	sources := reportSubscriptionSources
+	if source := d.EqualsQualString("source"); source != "" {
+		sources = []string{source}
+	}
+
+	apiVersion := getAPIVersion(GetConfig(d.Connection))
+	for _, source := range sources {
+		params := url.Values{"source": {source}}
+		if recordID := d.EqualsQualString("record_id"); recordID != "" {
+			params.Set("recordId", recordID)
+		}
+		path := fmt.Sprintf("services/data/v%s/analytics/notifications?%s", apiVersion, params.Encode())
+
+		var data []byte
+		client, data, err = restGetWithRetry(ctx, d, client, path)
+		if err != nil {
+			plugin.Logger(ctx).Error("salesforce_report_subscription.listSalesforceReportSubscriptions", "source", source, "query error", err)
+			return nil, err
+		}
+
+		rows, err := parseReportSubscriptions(data)
+		if err != nil {
+			plugin.Logger(ctx).Error("salesforce_report_subscription.listSalesforceReportSubscriptions", "source", source, "results decoding error", err)
+			return nil, err
+		}
+		for _, row := range rows {
+			d.StreamListItem(ctx, row)
+		}
+	}
+
+	return nil, nil
+}
+
+// parseReportSubscriptions converts an Analytics Notifications API response
+// into rows, collecting the recipients of every email action.
+func parseReportSubscriptions(data []byte) ([]reportSubscriptionRow, error) {
+	var notifications []analyticsNotification
+	if err := json.Unmarshal(data, &notifications); err != nil {
+		return nil, fmt.Errorf("failed to parse notifications response: %v", err)
+	}
+
+	rows := []reportSubscriptionRow{}
+	for _, n := range notifications {
+		row := reportSubscriptionRow{
+			ID:               n.ID,
+			Name:             n.Name,
+			Source:           n.Source,
+			Active:           n.Active,
+			OwnerID:          n.Owner.ID,
+			OwnerName:        n.Owner.Name,
+			RecordID:         n.RecordID,
+			Frequency:        n.Schedule.Frequency,
+			Schedule:         n.Schedule.Details,
+			Recipients:       []reportSubscriptionRecipient{},
+			CreatedDate:      n.CreatedDate,
+			LastModifiedDate: n.LastModifiedDate,
+		}
+		for _, threshold := range n.Thresholds {
+			for _, action := range threshold.Actions {
+				if action.Type == "sendEmail" {
+					row.Recipients = append(row.Recipients, action.Configuration.Recipients...)
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
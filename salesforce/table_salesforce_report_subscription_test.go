@@ -0,0 +1,79 @@
+package salesforce
+
+import (
+	"testing"
+)
+
+func TestParseReportSubscriptions(t *testing.T) {
+	t.Run("maps subscriber, schedule and target", func(t *testing.T) {
+		data := []byte(`[{
+			"id": "0Auxx0000000001",
+			"name": "Weekly pipeline",
+			"source": "lightningReportSubscribe",
+			"active": true,
+			"recordId": "00Oxx0000000001",
+			"createdDate": "2024-01-15T10:00:00Z",
+			"lastModifiedDate": "2024-02-01T08:30:00Z",
+			"owner": {"id": "005xx0000000001", "name": "Ada Admin"},
+			"schedule": {"frequency": "weekly", "details": {"time": 9, "daysOfWeek": ["mon"]}},
+			"thresholds": [{
+				"type": "always",
+				"actions": [{
+					"type": "sendEmail",
+					"configuration": {"recipients": [
+						{"id": "005xx0000000002", "displayName": "Sam Sales", "type": "user"},
+						{"id": "00Gxx0000000001", "displayName": "Sales Team", "type": "group"}
+					]}
+				}]
+			}]
+		}]`)
+
+		rows, err := parseReportSubscriptions(data)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(rows) != 1 {
+			t.Fatalf("len = %d, want 1", len(rows))
+		}
+		row := rows[0]
+		if row.ID != "0Auxx0000000001" || row.Source != "lightningReportSubscribe" || !row.Active {
+			t.Errorf("row = %+v, want active report subscription 0Auxx0000000001", row)
+		}
+		if row.OwnerID != "005xx0000000001" || row.OwnerName != "Ada Admin" {
+			t.Errorf("owner = %q/%q, want 005xx0000000001/Ada Admin", row.OwnerID, row.OwnerName)
+		}
+		if row.RecordID != "00Oxx0000000001" {
+			t.Errorf("RecordID = %q, want 00Oxx0000000001", row.RecordID)
+		}
+		if row.Frequency != "weekly" || row.Schedule["time"] != float64(9) {
+			t.Errorf("schedule = %q %v, want weekly at 9", row.Frequency, row.Schedule)
+		}
+		if len(row.Recipients) != 2 || row.Recipients[1].Type != "group" || row.Recipients[1].DisplayName != "Sales Team" {
+			t.Errorf("Recipients = %+v, want user and group recipients", row.Recipients)
+		}
+	})
+
+	t.Run("ignores non-email actions", func(t *testing.T) {
+		data := []byte(`[{"id": "0Auxx0000000002", "thresholds": [{"actions": [{"type": "sendNotification", "configuration": {"recipients": [{"id": "005xx0000000003"}]}}]}]}]`)
+		rows, err := parseReportSubscriptions(data)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(rows[0].Recipients) != 0 {
+			t.Errorf("Recipients = %+v, want none", rows[0].Recipients)
+		}
+	})
+
+	t.Run("empty response", func(t *testing.T) {
+		rows, err := parseReportSubscriptions([]byte(`[]`))
+		if err != nil || len(rows) != 0 {
+			t.Errorf("rows = %+v, err = %v, want no rows", rows, err)
+		}
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		if _, err := parseReportSubscriptions([]byte(`{"errorCode"`)); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceServiceAppointment(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "ServiceAppointment"
+	return &plugin.Table{
+		Name:        "salesforce_service_appointment",
+		Description: "Represents an appointment to perform field service work for a customer, such as a work order.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the service appointment in Salesforce."},
+			{Name: "appointment_number", Type: proto.ColumnType_STRING, Description: "Auto-generated number identifying the service appointment."},
+			{Name: "parent_record_id", Type: proto.ColumnType_STRING, Description: "ID of the record the appointment is for, such as a work order, work order line item, account or asset."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "Status of the appointment, such as None, Scheduled, Dispatched, In Progress, Completed or Canceled."},
+			{Name: "status_category", Type: proto.ColumnType_STRING, Description: "Category the status belongs to, which groups custom status values, such as Scheduled or Completed."},
+			{Name: "sched_start_time", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time the appointment is scheduled to start."},
+			{Name: "sched_end_time", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time the appointment is scheduled to end."},
+			{Name: "actual_start_time", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time the appointment actually started."},
+			{Name: "actual_end_time", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time the appointment actually ended."},
+
+			// Other columns
+			{Name: "account_id", Type: proto.ColumnType_STRING, Description: "ID of the account associated with the appointment."},
+			{Name: "actual_duration", Type: proto.ColumnType_DOUBLE, Description: "Number of minutes the appointment took."},
+			{Name: "arrival_window_end_time", Type: proto.ColumnType_TIMESTAMP, Description: "End of the arrival window communicated to the customer."},
+			{Name: "arrival_window_start_time", Type: proto.ColumnType_TIMESTAMP, Description: "Start of the arrival window communicated to the customer."},
+			{Name: "contact_id", Type: proto.ColumnType_STRING, Description: "ID of the contact associated with the appointment."},
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the appointment."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the creation of the appointment."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "Description of the appointment."},
+			{Name: "due_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time by which the appointment must be completed."},
+			{Name: "duration", Type: proto.ColumnType_DOUBLE, Description: "Estimated duration of the appointment, in the unit of duration_type."},
+			{Name: "duration_type", Type: proto.ColumnType_STRING, Description: "Unit of the duration: Minutes or Hours."},
+			{Name: "earliest_start_time", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time after which the appointment must start."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the appointment has been moved to the Recycle Bin (true) or not (false)."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who most recently changed the appointment."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the most recent change to the appointment."},
+			{Name: "owner_id", Type: proto.ColumnType_STRING, Description: "ID of the user or queue that owns the appointment."},
+			{Name: "service_territory_id", Type: proto.ColumnType_STRING, Description: "ID of the service territory where the appointment takes place."},
+			{Name: "subject", Type: proto.ColumnType_STRING, Description: "Subject of the appointment."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the appointment was last modified by a user or by an automated process."},
+			{Name: "work_type_id", Type: proto.ColumnType_STRING, Description: "ID of the work type the appointment is based on."},
+		}),
+	}
+}
@@ -0,0 +1,53 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceServiceContract(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "ServiceContract"
+	return &plugin.Table{
+		Name:        "salesforce_service_contract",
+		Description: "Represents a customer support agreement, such as a warranty or a subscription, whose entitlements set the support the customer receives.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the service contract in Salesforce."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "Name of the service contract."},
+			{Name: "contract_number", Type: proto.ColumnType_STRING, Description: "Automatically generated number that identifies the service contract."},
+			{Name: "account_id", Type: proto.ColumnType_STRING, Description: "ID of the account the service contract is with."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "Status of the service contract, Active, Expired or Inactive, computed from its start and end dates."},
+			{Name: "start_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date on which the service contract starts."},
+			{Name: "end_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date on which the service contract ends."},
+
+			// Other columns
+			{Name: "approval_status", Type: proto.ColumnType_STRING, Description: "Approval status of the service contract, such as Draft or Approved."},
+			{Name: "contact_id", Type: proto.ColumnType_STRING, Description: "ID of the customer contact of the service contract."},
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the service contract."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the creation of the service contract."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "Description of the service contract."},
+			{Name: "grand_total", Type: proto.ColumnType_DOUBLE, Description: "Total price of the service contract plus shipping and taxes."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the service contract has been moved to the Recycle Bin (true) or not (false)."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who most recently changed the service contract."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the most recent change to the service contract."},
+			{Name: "line_item_count", Type: proto.ColumnType_INT, Description: "Number of line items of the service contract."},
+			{Name: "owner_id", Type: proto.ColumnType_STRING, Description: "ID of the user who owns the service contract."},
+			{Name: "parent_service_contract_id", Type: proto.ColumnType_STRING, Description: "ID of the service contract this one is a child of."},
+			{Name: "pricebook_2_id", Type: proto.ColumnType_STRING, Description: "ID of the price book of the service contract."},
+			{Name: "root_service_contract_id", Type: proto.ColumnType_STRING, Description: "ID of the top-level service contract of the hierarchy this one belongs to."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the service contract was last modified by a user or by an automated process."},
+			{Name: "term", Type: proto.ColumnType_INT, Description: "Number of months the service contract is valid for."},
+			{Name: "total_price", Type: proto.ColumnType_DOUBLE, Description: "Total of the line items after discounts, before shipping and taxes."},
+		}),
+	}
+}
@@ -0,0 +1,151 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// sObjectRow holds the object-level properties of an sObject describe, which
+// the global describe also returns for each object.
+// Ref: https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_sobject_describe.htm
+type sObjectRow struct {
+	Name       string `json:"name"`
+	Label      string `json:"label"`
+	KeyPrefix  string `json:"keyPrefix"`
+	Custom     bool   `json:"custom"`
+	Queryable  bool   `json:"queryable"`
+	Createable bool   `json:"createable"`
+	Updateable bool   `json:"updateable"`
+	Deletable  bool   `json:"deletable"`
+	ReadOnly   bool   `json:"-"`
+}
+
+func SalesforceSObject(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_sobject",
+		Description: "The objects of the Salesforce org, with the operations the connection's user can perform on their records.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceSObjects,
+			KeyColumns: plugin.OptionalColumns([]string{"name"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The API name of the object.", Transform: transform.FromField("Name")},
+			{Name: "label", Type: proto.ColumnType_STRING, Description: "The label of the object.", Transform: transform.FromField("Label")},
+			{Name: "key_prefix", Type: proto.ColumnType_STRING, Description: "The first three characters of the IDs of the object's records, e.g. 001 for Account.", Transform: transform.FromField("KeyPrefix").NullIfZero()},
+			{Name: "is_custom", Type: proto.ColumnType_BOOL, Description: "True if the object is a custom object.", Transform: transform.FromField("Custom")},
+			{Name: "is_queryable", Type: proto.ColumnType_BOOL, Description: "True if the object's records can be queried.", Transform: transform.FromField("Queryable")},
+			{Name: "is_createable", Type: proto.ColumnType_BOOL, Description: "True if records of the object can be created.", Transform: transform.FromField("Createable")},
+			{Name: "is_updateable", Type: proto.ColumnType_BOOL, Description: "True if records of the object can be updated.", Transform: transform.FromField("Updateable")},
+			{Name: "is_deletable", Type: proto.ColumnType_BOOL, Description: "True if records of the object can be deleted.", Transform: transform.FromField("Deletable")},
+			{Name: "is_read_only", Type: proto.ColumnType_BOOL, Description: "True if records of the object can't be created, updated or deleted, such as history and share objects.", Transform: transform.FromField("ReadOnly")},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceSObjects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_sobject.listSalesforceSObjects", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_sobject.listSalesforceSObjects: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	rows, err := getGlobalDescribe(ctx, d, client)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_sobject.listSalesforceSObjects", "global describe error", err)
+		return nil, err
+	}
+
+	name := d.EqualsQualString("name")
+	for _, row := range rows {
+		if name != "" && !strings.EqualFold(row.Name, name) {
+			continue
+		}
+		d.StreamListItem(ctx, row)
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+// getGlobalDescribe returns the objects of the org from the global describe.
+// Like object describes, the result is kept in the connection cache for
+// describe_cache_ttl_seconds.
+// Ref: https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_describeGlobal.htm
+func getGlobalDescribe(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client) ([]sObjectRow, error) {
+	config := GetConfig(d.Connection)
+	// Object names can't contain "*", so the key can't clash with an object's
+	cacheKey := describeCacheKey(config, "*")
+	ttl := describeCacheTTL(config)
+	if d.ConnectionCache != nil && ttl > 0 {
+		if cachedData, ok := d.ConnectionCache.Get(ctx, cacheKey); ok {
+			if rows, ok := cachedData.([]sObjectRow); ok {
+				return rows, nil
+			}
+		}
+	}
+
+	// simpleforce's DescribeGlobal() requests the login URL rather than the
+	// instance URL, and doesn't check the response status
+	path := fmt.Sprintf("services/data/v%s/sobjects", getAPIVersion(config))
+	_, data, err := restGetWithRetry(ctx, d, client, path)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := buildSObjectRows(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.ConnectionCache != nil && ttl > 0 {
+		if err := d.ConnectionCache.SetWithTTL(ctx, cacheKey, rows, ttl); err != nil {
+			plugin.Logger(ctx).Error("salesforce_sobject.getGlobalDescribe", "cache-set", err)
+		}
+	}
+	return rows, nil
+}
+
+// buildSObjectRows parses the objects of a global describe response.
+func buildSObjectRows(data []byte) ([]sObjectRow, error) {
+	var response struct {
+		SObjects []sObjectRow `json:"sobjects"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse global describe: %v", err)
+	}
+	for i := range response.SObjects {
+		row := &response.SObjects[i]
+		row.ReadOnly = !row.Createable && !row.Updateable && !row.Deletable
+	}
+	return response.SObjects, nil
+}
+
+// buildSObjectRow extracts the object-level properties of a describe. An
+// object is read-only when its records can't be created, updated or deleted.
+func buildSObjectRow(sObjectMeta simpleforce.SObjectMeta) (sObjectRow, error) {
+	var row sObjectRow
+	data, err := json.Marshal(sObjectMeta)
+	if err != nil {
+		return row, err
+	}
+	if err := json.Unmarshal(data, &row); err != nil {
+		return row, fmt.Errorf("failed to parse describe of %v: %v", sObjectMeta["name"], err)
+	}
+	row.ReadOnly = !row.Createable && !row.Updateable && !row.Deletable
+	return row, nil
+}
@@ -0,0 +1,139 @@
+package salesforce
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/connection"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func TestBuildSObjectRow(t *testing.T) {
+	tests := []struct {
+		name     string
+		describe string
+		expected sObjectRow
+	}{
+		{
+			name:     "writable standard object",
+			describe: `{"name":"Account","label":"Account","custom":false,"queryable":true,"createable":true,"updateable":true,"deletable":true,"fields":[]}`,
+			expected: sObjectRow{Name: "Account", Label: "Account", Queryable: true, Createable: true, Updateable: true, Deletable: true},
+		},
+		{
+			name:     "read-only history object",
+			describe: `{"name":"OpportunityHistory","label":"Opportunity History","custom":false,"queryable":true,"createable":false,"updateable":false,"deletable":false}`,
+			expected: sObjectRow{Name: "OpportunityHistory", Label: "Opportunity History", Queryable: true, ReadOnly: true},
+		},
+		{
+			name:     "deletable but not createable",
+			describe: `{"name":"ProcessInstanceWorkitem","label":"Approval Request","queryable":true,"createable":false,"updateable":false,"deletable":true}`,
+			expected: sObjectRow{Name: "ProcessInstanceWorkitem", Label: "Approval Request", Queryable: true, Deletable: true},
+		},
+		{
+			name:     "custom object",
+			describe: `{"name":"Invoice__c","label":"Invoice","custom":true,"queryable":true,"createable":true,"updateable":true,"deletable":true}`,
+			expected: sObjectRow{Name: "Invoice__c", Label: "Invoice", Custom: true, Queryable: true, Createable: true, Updateable: true, Deletable: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var meta simpleforce.SObjectMeta
+			if err := json.Unmarshal([]byte(tt.describe), &meta); err != nil {
+				t.Fatalf("invalid describe: %v", err)
+			}
+			got, err := buildSObjectRow(meta)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("got %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("invalid describe", func(t *testing.T) {
+		if _, err := buildSObjectRow(simpleforce.SObjectMeta{"createable": "yes"}); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+const globalDescribe = `{"encoding":"UTF-8","maxBatchSize":200,"sobjects":[
+	{"name":"Account","label":"Account","keyPrefix":"001","custom":false,"queryable":true,"createable":true,"updateable":true,"deletable":true,"urls":{"sobject":"/services/data/v62.0/sobjects/Account"}},
+	{"name":"AccountHistory","label":"Account History","keyPrefix":"017","custom":false,"queryable":true,"createable":false,"updateable":false,"deletable":false},
+	{"name":"AccountChangeEvent","label":"Account Change Event","keyPrefix":null,"custom":false,"queryable":false,"createable":false,"updateable":false,"deletable":false},
+	{"name":"Invoice__c","label":"Invoice","keyPrefix":"a01","custom":true,"queryable":true,"createable":true,"updateable":true,"deletable":true}
+]}`
+
+func TestBuildSObjectRows(t *testing.T) {
+	rows, err := buildSObjectRows([]byte(globalDescribe))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []sObjectRow{
+		{Name: "Account", Label: "Account", KeyPrefix: "001", Queryable: true, Createable: true, Updateable: true, Deletable: true},
+		{Name: "AccountHistory", Label: "Account History", KeyPrefix: "017", Queryable: true, ReadOnly: true},
+		{Name: "AccountChangeEvent", Label: "Account Change Event", ReadOnly: true},
+		{Name: "Invoice__c", Label: "Invoice", KeyPrefix: "a01", Custom: true, Queryable: true, Createable: true, Updateable: true, Deletable: true},
+	}
+	if !slices.Equal(rows, expected) {
+		t.Errorf("rows = %+v, want %+v", rows, expected)
+	}
+
+	if _, err := buildSObjectRows([]byte(`{"sobjects":{}}`)); err == nil {
+		t.Error("expected error for an invalid response, got nil")
+	}
+}
+
+func TestGetGlobalDescribe(t *testing.T) {
+	describes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/services/data/v"+defaultAPIVersion+"/sobjects" {
+			http.NotFound(w, r)
+			return
+		}
+		describes++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(globalDescribe))
+	}))
+	t.Cleanup(server.Close)
+
+	var buf bytes.Buffer
+	ctx := contextWithLogger(&buf)
+	cc, err := connection.NewConnectionCache("salesforce_test", 1000000)
+	if err != nil {
+		t.Fatalf("NewConnectionCache: %v", err)
+	}
+	d := &plugin.QueryData{Connection: &plugin.Connection{Config: salesforceConfig{URL: stringPtr(server.URL), AccessToken: stringPtr("token")}}, ConnectionCache: cc}
+	client, err := connect(ctx, d)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		rows, err := getGlobalDescribe(ctx, d, client)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(rows) != 4 || rows[0].Name != "Account" {
+			t.Fatalf("rows = %+v, want the 4 objects", rows)
+		}
+	}
+	if describes != 1 {
+		t.Errorf("describes = %d, want the global describe cached", describes)
+	}
+
+	disabled := 0
+	d.Connection.Config = salesforceConfig{URL: stringPtr(server.URL), AccessToken: stringPtr("token"), DescribeCacheTTLSeconds: &disabled}
+	if _, err := getGlobalDescribe(ctx, d, client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if describes != 2 {
+		t.Errorf("describes = %d, want an uncached describe when caching is disabled", describes)
+	}
+}
@@ -0,0 +1,110 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Salesforce counts most records as 2 KB of data storage, regardless of the
+// number of fields or their content.
+// https://help.salesforce.com/s/articleView?id=sf.overview_storage.htm&type=5
+const recordStorageKB = 2
+
+type storageUsageRow struct {
+	ObjectName             string
+	RecordCount            int
+	EstimatedStorageMB     float64
+	DataStorageMaxMB       int64
+	DataStorageRemainingMB int64
+	PercentOfLimit         float64
+}
+
+func SalesforceStorageUsage(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_storage_usage",
+		Description: "Estimated data storage used by each configured Salesforce object, based on its record count and the org's data storage limit.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceStorageUsage,
+			KeyColumns: plugin.OptionalColumns([]string{"object_name"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "object_name", Type: proto.ColumnType_STRING, Description: "The API name of the Salesforce object.", Transform: transform.FromField("ObjectName")},
+			{Name: "record_count", Type: proto.ColumnType_INT, Description: "Number of records of the object, as returned by SELECT COUNT().", Transform: transform.FromField("RecordCount")},
+			{Name: "estimated_storage_mb", Type: proto.ColumnType_DOUBLE, Description: "Estimated data storage used by the object's records in MB, assuming 2 KB per record.", Transform: transform.FromField("EstimatedStorageMB")},
+			{Name: "data_storage_max_mb", Type: proto.ColumnType_INT, Description: "The org's data storage limit in MB.", Transform: transform.FromField("DataStorageMaxMB")},
+			{Name: "data_storage_remaining_mb", Type: proto.ColumnType_INT, Description: "The org's remaining data storage in MB.", Transform: transform.FromField("DataStorageRemainingMB")},
+			{Name: "percent_of_limit", Type: proto.ColumnType_DOUBLE, Description: "Estimated storage of the object as a percentage of the org's data storage limit.", Transform: transform.FromField("PercentOfLimit")},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceStorageUsage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_storage_usage.listSalesforceStorageUsage", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_storage_usage.listSalesforceStorageUsage: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	limits, err := getOrgLimits(ctx, d, client)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_storage_usage.listSalesforceStorageUsage", "limits error", err)
+		return nil, err
+	}
+
+	objectNames := configuredObjectNames(GetConfig(d.Connection))
+	if name := d.EqualsQualString("object_name"); name != "" {
+		objectNames = []string{name}
+	}
+
+	counts := map[string]int{}
+	for _, objectName := range objectNames {
+		count, err := countRecords(ctx, d, client, objectName, "")
+		if err != nil {
+			// Configured objects may not exist or be queryable in every org
+			plugin.Logger(ctx).Warn("salesforce_storage_usage.listSalesforceStorageUsage", "object_name", objectName, "count error", err)
+			continue
+		}
+		counts[objectName] = count
+	}
+
+	for _, row := range buildStorageUsageRows(objectNames, counts, limits["DataStorageMB"]) {
+		d.StreamListItem(ctx, row)
+	}
+
+	return nil, nil
+}
+
+// buildStorageUsageRows combines per-object record counts with the org's data
+// storage limit. Objects without a count are skipped, and rows keep the order
+// of objectNames.
+func buildStorageUsageRows(objectNames []string, counts map[string]int, dataStorage orgLimit) []storageUsageRow {
+	rows := []storageUsageRow{}
+	for _, name := range objectNames {
+		count, ok := counts[name]
+		if !ok {
+			continue
+		}
+		row := storageUsageRow{
+			ObjectName:             name,
+			RecordCount:            count,
+			EstimatedStorageMB:     float64(count) * recordStorageKB / 1024,
+			DataStorageMaxMB:       dataStorage.Max,
+			DataStorageRemainingMB: dataStorage.Remaining,
+		}
+		if dataStorage.Max > 0 {
+			row.PercentOfLimit = row.EstimatedStorageMB / float64(dataStorage.Max) * 100
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -0,0 +1,56 @@
+package salesforce
+
+import (
+	"testing"
+)
+
+func TestBuildStorageUsageRows(t *testing.T) {
+	t.Run("estimates storage and percent of limit", func(t *testing.T) {
+		counts := map[string]int{"Account": 1024, "Contact": 512}
+		rows := buildStorageUsageRows([]string{"Account", "Contact"}, counts, orgLimit{Max: 20, Remaining: 15})
+		if len(rows) != 2 {
+			t.Fatalf("len = %d, want 2", len(rows))
+		}
+		if rows[0].ObjectName != "Account" || rows[0].RecordCount != 1024 {
+			t.Errorf("rows[0] = %+v, want Account with 1024 records", rows[0])
+		}
+		if rows[0].EstimatedStorageMB != 2 {
+			t.Errorf("rows[0].EstimatedStorageMB = %v, want 2", rows[0].EstimatedStorageMB)
+		}
+		if rows[0].PercentOfLimit != 10 {
+			t.Errorf("rows[0].PercentOfLimit = %v, want 10", rows[0].PercentOfLimit)
+		}
+		if rows[1].EstimatedStorageMB != 1 {
+			t.Errorf("rows[1].EstimatedStorageMB = %v, want 1", rows[1].EstimatedStorageMB)
+		}
+		if rows[1].DataStorageMaxMB != 20 || rows[1].DataStorageRemainingMB != 15 {
+			t.Errorf("rows[1] limits = %d/%d, want 20/15", rows[1].DataStorageMaxMB, rows[1].DataStorageRemainingMB)
+		}
+	})
+
+	t.Run("objects without a count are skipped", func(t *testing.T) {
+		counts := map[string]int{"Account": 10}
+		rows := buildStorageUsageRows([]string{"Missing__c", "Account"}, counts, orgLimit{Max: 5})
+		if len(rows) != 1 || rows[0].ObjectName != "Account" {
+			t.Errorf("rows = %+v, want only Account", rows)
+		}
+	})
+
+	t.Run("zero limit leaves percent empty", func(t *testing.T) {
+		rows := buildStorageUsageRows([]string{"Account"}, map[string]int{"Account": 10}, orgLimit{})
+		if rows[0].PercentOfLimit != 0 {
+			t.Errorf("PercentOfLimit = %v, want 0", rows[0].PercentOfLimit)
+		}
+	})
+}
+
+func TestConfiguredObjectNames(t *testing.T) {
+	objects := []string{"Account", "CustomApp__c"}
+	got := configuredObjectNames(salesforceConfig{Objects: &objects})
+	if len(got) != len(staticTables)+1 {
+		t.Fatalf("len = %d, want %d", len(got), len(staticTables)+1)
+	}
+	if got[len(got)-1] != "CustomApp__c" {
+		t.Errorf("last = %q, want CustomApp__c", got[len(got)-1])
+	}
+}
@@ -0,0 +1,155 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const (
+	territoryAssignmentRuleQuery     = "SELECT Id, DeveloperName, MasterLabel, Territory2ModelId, ObjectType, IsActive, BooleanFilter FROM ObjectTerritory2AssignmentRule"
+	territoryAssignmentRuleItemQuery = "SELECT Id, RuleId, SortOrder, Field, Operation, Value FROM ObjectTerritory2AssignmentRuleItem"
+)
+
+type territoryAssignmentRuleRow struct {
+	ID                string
+	DeveloperName     string
+	MasterLabel       string
+	Territory2ModelID string
+	ObjectType        string
+	IsActive          bool
+	BooleanFilter     string
+	RuleItems         []territoryAssignmentRuleItem
+}
+
+type territoryAssignmentRuleItem struct {
+	SortOrder int    `json:"sort_order"`
+	Field     string `json:"field"`
+	Operation string `json:"operation"`
+	Value     string `json:"value"`
+}
+
+func SalesforceTerritoryAssignmentRule(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_territory_assignment_rule",
+		Description: "Represents an Enterprise Territory Management assignment rule, with the criteria it uses to assign records to territories.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceTerritoryAssignmentRules,
+			KeyColumns: plugin.OptionalColumns([]string{"territory2_model_id", "object_type"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The ID of the assignment rule.", Transform: transform.FromField("ID")},
+			{Name: "developer_name", Type: proto.ColumnType_STRING, Description: "The unique API name of the assignment rule.", Transform: transform.FromField("DeveloperName")},
+			{Name: "master_label", Type: proto.ColumnType_STRING, Description: "The label of the assignment rule.", Transform: transform.FromField("MasterLabel")},
+			{Name: "territory2_model_id", Type: proto.ColumnType_STRING, Description: "The ID of the territory model the rule belongs to.", Transform: transform.FromField("Territory2ModelID")},
+			{Name: "object_type", Type: proto.ColumnType_STRING, Description: "The object the rule assigns, for example Account.", Transform: transform.FromField("ObjectType")},
+			{Name: "is_active", Type: proto.ColumnType_BOOL, Description: "True if the rule is active.", Transform: transform.FromField("IsActive")},
+			{Name: "boolean_filter", Type: proto.ColumnType_STRING, Description: "Advanced logic combining the rule items by their sort order, for example 1 AND (2 OR 3). Empty if all items must match.", Transform: transform.FromField("BooleanFilter").NullIfZero()},
+			{Name: "rule_items", Type: proto.ColumnType_JSON, Description: "The criteria of the rule, ordered by sort_order. Each item has a field, operation and value.", Transform: transform.FromField("RuleItems")},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceTerritoryAssignmentRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_territory_assignment_rule.listSalesforceTerritoryAssignmentRules", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_territory_assignment_rule.listSalesforceTerritoryAssignmentRules: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	modelID, objectType := d.EqualsQualString("territory2_model_id"), d.EqualsQualString("object_type")
+
+	ruleQuery := territoryAssignmentRuleQuery
+	if condition := buildTerritoryAssignmentRuleCondition("", modelID, objectType); condition != "" {
+		ruleQuery = fmt.Sprintf("%s WHERE %s", ruleQuery, condition)
+	}
+	client, rules, err := queryAllRecords(ctx, d, client, "ObjectTerritory2AssignmentRule", ruleQuery)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_territory_assignment_rule.listSalesforceTerritoryAssignmentRules", "rule query error", err)
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	// Fetch the items of the same rules through the parent relationship
+	itemQuery := territoryAssignmentRuleItemQuery
+	if condition := buildTerritoryAssignmentRuleCondition("Rule.", modelID, objectType); condition != "" {
+		itemQuery = fmt.Sprintf("%s WHERE %s", itemQuery, condition)
+	}
+	_, items, err := queryAllRecords(ctx, d, client, "ObjectTerritory2AssignmentRuleItem", itemQuery)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_territory_assignment_rule.listSalesforceTerritoryAssignmentRules", "rule item query error", err)
+		return nil, err
+	}
+
+	for _, row := range buildTerritoryAssignmentRuleRows(rules, items) {
+		d.StreamListItem(ctx, row)
+	}
+
+	return nil, nil
+}
+
+// buildTerritoryAssignmentRuleCondition returns the SOQL WHERE condition for
+// the optional territory2_model_id and object_type quals. prefix is the path to
+// the rule, e.g. "Rule." when querying rule items.
+func buildTerritoryAssignmentRuleCondition(prefix, modelID, objectType string) string {
+	filters := []string{}
+	if modelID != "" {
+		filters = append(filters, fmt.Sprintf("%sTerritory2ModelId = '%s'", prefix, escapeSOQLString(modelID)))
+	}
+	if objectType != "" {
+		filters = append(filters, fmt.Sprintf("%sObjectType = '%s'", prefix, escapeSOQLString(objectType)))
+	}
+	return strings.Join(filters, " AND ")
+}
+
+// buildTerritoryAssignmentRuleRows attaches each rule item record to its rule,
+// ordered by sort order. Rows keep the order of the rule records.
+func buildTerritoryAssignmentRuleRows(rules []map[string]interface{}, items []map[string]interface{}) []territoryAssignmentRuleRow {
+	itemsByRule := map[string][]territoryAssignmentRuleItem{}
+	for _, record := range items {
+		ruleID, _ := record["RuleId"].(string)
+		item := territoryAssignmentRuleItem{}
+		if sortOrder, ok := record["SortOrder"].(float64); ok {
+			item.SortOrder = int(sortOrder)
+		}
+		item.Field, _ = record["Field"].(string)
+		item.Operation, _ = record["Operation"].(string)
+		item.Value, _ = record["Value"].(string)
+		itemsByRule[ruleID] = append(itemsByRule[ruleID], item)
+	}
+
+	rows := []territoryAssignmentRuleRow{}
+	for _, record := range rules {
+		row := territoryAssignmentRuleRow{}
+		row.ID, _ = record["Id"].(string)
+		row.DeveloperName, _ = record["DeveloperName"].(string)
+		row.MasterLabel, _ = record["MasterLabel"].(string)
+		row.Territory2ModelID, _ = record["Territory2ModelId"].(string)
+		row.ObjectType, _ = record["ObjectType"].(string)
+		row.IsActive, _ = record["IsActive"].(bool)
+		row.BooleanFilter, _ = record["BooleanFilter"].(string)
+
+		row.RuleItems = itemsByRule[row.ID]
+		if row.RuleItems == nil {
+			row.RuleItems = []territoryAssignmentRuleItem{}
+		}
+		sort.SliceStable(row.RuleItems, func(i, j int) bool {
+			return row.RuleItems[i].SortOrder < row.RuleItems[j].SortOrder
+		})
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -0,0 +1,83 @@
+package salesforce
+
+import (
+	"testing"
+)
+
+func TestBuildTerritoryAssignmentRuleRows(t *testing.T) {
+	rules := []map[string]interface{}{
+		{
+			"attributes":        map[string]interface{}{"type": "ObjectTerritory2AssignmentRule"},
+			"Id":                "0OHxx0000000001",
+			"DeveloperName":     "West_Coast",
+			"MasterLabel":       "West Coast",
+			"Territory2ModelId": "0MAxx0000000001",
+			"ObjectType":        "Account",
+			"IsActive":          true,
+			"BooleanFilter":     "1 AND (2 OR 3)",
+		},
+		{
+			"Id":         "0OHxx0000000002",
+			"ObjectType": "Account",
+			"IsActive":   false,
+		},
+	}
+	items := []map[string]interface{}{
+		{"RuleId": "0OHxx0000000001", "SortOrder": float64(3), "Field": "Account.BillingState", "Operation": "equals", "Value": "OR"},
+		{"RuleId": "0OHxx0000000001", "SortOrder": float64(1), "Field": "Account.BillingCountry", "Operation": "equals", "Value": "US"},
+		{"RuleId": "0OHxx0000000001", "SortOrder": float64(2), "Field": "Account.BillingState", "Operation": "equals", "Value": "CA,WA"},
+		{"RuleId": "0OHxx0000000099", "SortOrder": float64(1), "Field": "Account.Industry", "Operation": "equals", "Value": "Retail"},
+	}
+
+	rows := buildTerritoryAssignmentRuleRows(rules, items)
+	if len(rows) != 2 {
+		t.Fatalf("len = %d, want 2", len(rows))
+	}
+
+	rule := rows[0]
+	if rule.DeveloperName != "West_Coast" || rule.MasterLabel != "West Coast" || rule.Territory2ModelID != "0MAxx0000000001" || !rule.IsActive {
+		t.Errorf("rows[0] = %+v, want active West_Coast rule", rule)
+	}
+	if rule.BooleanFilter != "1 AND (2 OR 3)" {
+		t.Errorf("BooleanFilter = %q, want 1 AND (2 OR 3)", rule.BooleanFilter)
+	}
+	if len(rule.RuleItems) != 3 {
+		t.Fatalf("len(RuleItems) = %d, want 3", len(rule.RuleItems))
+	}
+	for i, item := range rule.RuleItems {
+		if item.SortOrder != i+1 {
+			t.Errorf("RuleItems[%d].SortOrder = %d, want items ordered by sort order", i, item.SortOrder)
+		}
+	}
+	if rule.RuleItems[0] != (territoryAssignmentRuleItem{SortOrder: 1, Field: "Account.BillingCountry", Operation: "equals", Value: "US"}) {
+		t.Errorf("RuleItems[0] = %+v", rule.RuleItems[0])
+	}
+
+	if rows[1].IsActive || rows[1].BooleanFilter != "" {
+		t.Errorf("rows[1] = %+v, want inactive rule without boolean filter", rows[1])
+	}
+	if rows[1].RuleItems == nil || len(rows[1].RuleItems) != 0 {
+		t.Errorf("rows[1].RuleItems = %#v, want empty slice", rows[1].RuleItems)
+	}
+}
+
+func TestBuildTerritoryAssignmentRuleCondition(t *testing.T) {
+	tests := []struct {
+		name       string
+		prefix     string
+		modelID    string
+		objectType string
+		expected   string
+	}{
+		{"no quals", "", "", "", ""},
+		{"rule quals", "", "0MAxx", "Account", "Territory2ModelId = '0MAxx' AND ObjectType = 'Account'"},
+		{"item quals through the rule", "Rule.", "0MAxx", "", "Rule.Territory2ModelId = '0MAxx'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildTerritoryAssignmentRuleCondition(tt.prefix, tt.modelID, tt.objectType); got != tt.expected {
+				t.Errorf("got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func SalesforceToolingQuery(ctx context.Context, config salesforceConfig) *plugin.Table {
+	return &plugin.Table{
+		Name:        "salesforce_tooling_query",
+		Description: "Runs an arbitrary SOQL query with the Tooling API, e.g. on ApexClass, ApexTrigger or EntityDefinition, and returns each record as JSON.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceToolingQuery,
+			KeyColumns: plugin.SingleColumn("query"),
+		},
+		Columns: []*plugin.Column{
+			{Name: "query", Type: proto.ColumnType_STRING, Description: "The SOQL query to run with the Tooling API.", Transform: transform.FromField("Query")},
+			{Name: "result", Type: proto.ColumnType_JSON, Description: "The record returned by the query, as returned by the Tooling API.", Transform: transform.FromField("Result")},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the organization in Salesforce.", Hydrate: getOrganizationId, Transform: transform.FromValue()},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSalesforceToolingQuery(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	query := d.EqualsQualString("query")
+	if query == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_tooling_query.listSalesforceToolingQuery", "connection error", err)
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("salesforce_tooling_query.listSalesforceToolingQuery: client_not_found, unable to query table %s because of invalid steampipe salesforce configuration", d.Table.Name)
+	}
+
+	// The queried object isn't known, so the connection-level retry policy is
+	// used. Unlike salesforce_query, aggregate queries aren't checked, since
+	// Tooling API objects are described by another resource.
+	stripAttributes := stripChildRecordAttributes(GetConfig(d.Connection))
+	_, err = streamToolingQueryRecords(ctx, d, client, "", query, func(record map[string]interface{}) bool {
+		if stripAttributes {
+			removeChildRecordAttributes(record)
+		}
+		d.StreamListItem(ctx, queryRow{Query: query, Result: record})
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce_tooling_query.listSalesforceToolingQuery", "query error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
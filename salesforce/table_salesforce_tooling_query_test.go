@@ -0,0 +1,80 @@
+package salesforce
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func TestSalesforceToolingQueryTable(t *testing.T) {
+	table := SalesforceToolingQuery(contextWithLogger(&bytes.Buffer{}), salesforceConfig{})
+	keyColumns := table.List.KeyColumns
+	if len(keyColumns) != 1 || keyColumns[0].Name != "query" || keyColumns[0].Require != plugin.Required {
+		t.Errorf("key columns = %v, want a required query", keyColumns)
+	}
+	for _, col := range []string{"query", "result"} {
+		if !hasColumn(table.Columns, col) {
+			t.Errorf("missing column %q", col)
+		}
+	}
+}
+
+func TestSalesforceToolingQuery_Paging(t *testing.T) {
+	query := "SELECT Id, Name, Status FROM ApexClass WHERE NamespacePrefix = null"
+	toolingPath := "/services/data/v" + defaultAPIVersion + "/tooling/query"
+	requests := []string{}
+
+	// The pages are served by the transport of the client, without a server
+	instanceURL := "https://acme.my.salesforce.com"
+	client := simpleforce.NewClient(instanceURL, "steampipe", defaultAPIVersion)
+	client.SetSidLoc("sid", instanceURL)
+	client.SetHttpClient(&http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		requests = append(requests, r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer sid" {
+			t.Errorf("Authorization = %q, want the session id", r.Header.Get("Authorization"))
+		}
+		var body string
+		switch r.URL.Path {
+		case toolingPath:
+			if q := r.URL.Query().Get("q"); q != query {
+				t.Errorf("q = %q, want the SOQL sent unchanged", q)
+			}
+			body = fmt.Sprintf(`{"totalSize":3,"done":false,"nextRecordsUrl":"%s/01gxx-2000","records":[
+				{"attributes":{"type":"ApexClass"},"Id":"01pa","Name":"AccountService","Status":"Active"},
+				{"attributes":{"type":"ApexClass"},"Id":"01pb","Name":"AccountServiceTest","Status":"Active"}
+			]}`, toolingPath)
+		case toolingPath + "/01gxx-2000":
+			body = `{"totalSize":3,"done":true,"records":[{"attributes":{"type":"ApexClass"},"Id":"01pc","Name":"LegacyHandler","Status":"Deleted"}]}`
+		default:
+			return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(`[{"errorCode":"NOT_FOUND","message":"not found"}]`))}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	})})
+	d := &plugin.QueryData{Connection: &plugin.Connection{Config: salesforceConfig{URL: stringPtr(instanceURL), AccessToken: stringPtr("token")}}}
+
+	var buf bytes.Buffer
+	rows := []queryRow{}
+	_, err := streamToolingQueryRecords(contextWithLogger(&buf), d, client, "", query, func(record map[string]interface{}) bool {
+		rows = append(rows, queryRow{Query: query, Result: record})
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{toolingPath, toolingPath + "/01gxx-2000"}; strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Errorf("requests = %q, want %q", requests, expected)
+	}
+	if len(rows) != 3 || rows[0].Result["Name"] != "AccountService" || rows[2].Result["Status"] != "Deleted" {
+		t.Errorf("rows = %v, want the classes of both pages", rows)
+	}
+}
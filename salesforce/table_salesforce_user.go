@@ -20,7 +20,7 @@ func SalesforceUser(ctx context.Context, dm dynamicMap, config salesforceConfig)
 			Hydrate:    getSalesforceObjectbyID(tableName),
 			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
 		},
-		Columns: mergeTableColumns(ctx, config, dm.cols, []*plugin.Column{
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
 			// Top columns
 			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the user in Salesforce."},
 			{Name: "alias", Type: proto.ColumnType_STRING, Description: "The user's alias. For example, jsmith."},
@@ -0,0 +1,49 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceUserLoginHistory(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "LoginHistory"
+	return &plugin.Table{
+		Name:        "salesforce_user_login_history",
+		Description: "Represents a login attempt by a user, successful or not, in the last six months.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the login attempt in Salesforce."},
+			{Name: "user_id", Type: proto.ColumnType_STRING, Description: "ID of the user who attempted to log in."},
+			{Name: "login_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time of the login attempt."},
+			{Name: "source_ip", Type: proto.ColumnType_STRING, Description: "IP address the login attempt came from."},
+			{Name: "login_type", Type: proto.ColumnType_STRING, Description: "Type of login, such as Application, Remote Access 2.0 or SAML Sfdc Initiated SSO."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "Result of the login attempt, such as Success, Invalid Password or Failed: Computer activation required."},
+			{Name: "browser", Type: proto.ColumnType_STRING, Description: "Browser used for the login attempt, such as Chrome 120."},
+			{Name: "platform", Type: proto.ColumnType_STRING, Description: "Operating system used for the login attempt, such as Mac OSX or Windows 10."},
+			{Name: "application", Type: proto.ColumnType_STRING, Description: "Application used to log in, such as Browser or the name of a connected app."},
+
+			// Other columns
+			{Name: "api_type", Type: proto.ColumnType_STRING, Description: "Type of API used to log in, such as SOAP Enterprise or REST, for API logins."},
+			{Name: "api_version", Type: proto.ColumnType_STRING, Description: "Version of the API used to log in, for API logins."},
+			{Name: "authentication_service_id", Type: proto.ColumnType_STRING, Description: "ID of the authentication service, such as a SAML or auth provider, used to log in."},
+			{Name: "cipher_suite", Type: proto.ColumnType_STRING, Description: "TLS cipher suite used for the login attempt."},
+			{Name: "client_version", Type: proto.ColumnType_STRING, Description: "Version of the client used to log in."},
+			{Name: "country_iso", Type: proto.ColumnType_STRING, Description: "ISO 3166 code of the country the login attempt came from, as located from its IP address."},
+			{Name: "login_geo_id", Type: proto.ColumnType_STRING, Description: "ID of the geographic location of the login attempt."},
+			{Name: "login_sub_type", Type: proto.ColumnType_STRING, Description: "Subtype of the login, for logins to Experience Cloud sites and portals."},
+			{Name: "login_url", Type: proto.ColumnType_STRING, Description: "URL of the login page used."},
+			{Name: "network_id", Type: proto.ColumnType_STRING, Description: "ID of the Experience Cloud site the user logged in to."},
+			{Name: "tls_protocol", Type: proto.ColumnType_STRING, Description: "TLS protocol used for the login attempt, such as TLS 1.2."},
+		}),
+	}
+}
@@ -0,0 +1,55 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func SalesforceWorkOrder(ctx context.Context, dm dynamicMap, config salesforceConfig) *plugin.Table {
+	tableName := "WorkOrder"
+	return &plugin.Table{
+		Name:        "salesforce_work_order",
+		Description: "Represents field service work to be performed for a customer, such as an installation, repair or inspection.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSalesforceObjectsByTable(tableName, dm.salesforceColumns),
+			KeyColumns: dm.keyColumns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSalesforceObjectbyID(tableName),
+			KeyColumns: plugin.SingleColumn(checkNameScheme(config, dm.cols)),
+		},
+		Columns: mergeTableColumns(ctx, config, dm, []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "Unique identifier of the work order in Salesforce."},
+			{Name: "work_order_number", Type: proto.ColumnType_STRING, Description: "Auto-generated number identifying the work order."},
+			{Name: "subject", Type: proto.ColumnType_STRING, Description: "Subject of the work order."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "Status of the work order, such as New, In Progress or Completed."},
+			{Name: "status_category", Type: proto.ColumnType_STRING, Description: "Category the status belongs to, which groups custom status values, such as InProgress or Completed."},
+			{Name: "priority", Type: proto.ColumnType_STRING, Description: "Priority of the work order, such as Low, Medium, High or Critical."},
+			{Name: "start_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time the work order goes into effect."},
+			{Name: "end_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time the work order is completed."},
+
+			// Other columns
+			{Name: "account_id", Type: proto.ColumnType_STRING, Description: "ID of the account associated with the work order."},
+			{Name: "asset_id", Type: proto.ColumnType_STRING, Description: "ID of the asset associated with the work order."},
+			{Name: "case_id", Type: proto.ColumnType_STRING, Description: "ID of the case associated with the work order."},
+			{Name: "contact_id", Type: proto.ColumnType_STRING, Description: "ID of the contact associated with the work order."},
+			{Name: "created_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who created the work order."},
+			{Name: "created_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the creation of the work order."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "Description of the work order."},
+			{Name: "duration", Type: proto.ColumnType_DOUBLE, Description: "Estimated time to complete the work order, in the unit of duration_type."},
+			{Name: "duration_type", Type: proto.ColumnType_STRING, Description: "Unit of the duration: Minutes or Hours."},
+			{Name: "is_closed", Type: proto.ColumnType_BOOL, Description: "Indicates whether the work order is closed (true) or not (false)."},
+			{Name: "is_deleted", Type: proto.ColumnType_BOOL, Description: "Indicates whether the work order has been moved to the Recycle Bin (true) or not (false)."},
+			{Name: "last_modified_by_id", Type: proto.ColumnType_STRING, Description: "ID of the user who most recently changed the work order."},
+			{Name: "last_modified_date", Type: proto.ColumnType_TIMESTAMP, Description: "Date and time of the most recent change to the work order."},
+			{Name: "owner_id", Type: proto.ColumnType_STRING, Description: "ID of the user or queue that owns the work order."},
+			{Name: "parent_work_order_id", Type: proto.ColumnType_STRING, Description: "ID of the parent work order, if the work order is a child work order."},
+			{Name: "service_territory_id", Type: proto.ColumnType_STRING, Description: "ID of the service territory where the work order takes place."},
+			{Name: "system_modstamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time when the work order was last modified by a user or by an automated process."},
+			{Name: "work_type_id", Type: proto.ColumnType_STRING, Description: "ID of the work type the work order is based on."},
+		}),
+	}
+}
@@ -0,0 +1,45 @@
+package salesforce
+
+import (
+	"context"
+	"strings"
+
+	"github.com/simpleforce/simpleforce"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// toolingQueryResource is the REST resource of Tooling API queries, which
+// read setup metadata, such as Flow, that the query resource doesn't expose.
+// Ref: https://developer.salesforce.com/docs/atlas.en-us.api_tooling.meta/api_tooling/intro_rest_resources.htm
+const toolingQueryResource = "tooling/query"
+
+// runToolingQuery runs a SOQL query with the Tooling API. Like client.Query(),
+// it requests paging URLs, which already point to the Tooling API, as they
+// are. client.Tooling() isn't used, since it would switch every query of the
+// shared client to the Tooling API.
+func runToolingQuery(d *plugin.QueryData, client *simpleforce.Client, query string) (*simpleforce.QueryResult, error) {
+	if strings.HasPrefix(query, "/services/data") {
+		return client.Query(query)
+	}
+	return client.Query(queryPath(getAPIVersion(GetConfig(d.Connection)), toolingQueryResource, query))
+}
+
+// toolingQueryWithRetry is queryWithRetry for Tooling API queries.
+func toolingQueryWithRetry(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, objectName string, query string) (*simpleforce.Client, *simpleforce.QueryResult, error) {
+	var result *simpleforce.QueryResult
+	client, err := withSessionRetry(ctx, d, client, objectName, func(client *simpleforce.Client) (err error) {
+		result, err = runToolingQuery(d, client, query)
+		return err
+	})
+	if err != nil {
+		return client, nil, queryTimeoutError(err, GetConfig(d.Connection))
+	}
+	return client, result, nil
+}
+
+// streamToolingQueryRecords is streamQueryRecords for Tooling API queries.
+func streamToolingQueryRecords(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, objectName string, query string, stream func(record map[string]interface{}) bool) (*simpleforce.Client, error) {
+	return streamQueryPages(ctx, client, query, func(client *simpleforce.Client, query string) (*simpleforce.Client, *simpleforce.QueryResult, error) {
+		return toolingQueryWithRetry(ctx, d, client, objectName, query)
+	}, stream)
+}
@@ -0,0 +1,68 @@
+package salesforce
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func TestStreamToolingQueryRecords(t *testing.T) {
+	query := "SELECT Id, Status FROM Flow WHERE Status = 'Active'"
+	toolingPath := "/services/data/v" + defaultAPIVersion + "/tooling/query"
+	queries := []string{}
+	otherPaths := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case toolingPath:
+			queries = append(queries, r.URL.Query().Get("q"))
+			w.Write([]byte(`{"totalSize":3,"done":false,"nextRecordsUrl":"` + toolingPath + `/01g-1","records":[
+				{"attributes":{"type":"Flow"},"Id":"301a","Status":"Active"},
+				{"attributes":{"type":"Flow"},"Id":"301b","Status":"Active"}
+			]}`))
+		case toolingPath + "/01g-1":
+			w.Write([]byte(`{"totalSize":3,"done":true,"records":[{"attributes":{"type":"Flow"},"Id":"301c","Status":"Active"}]}`))
+		default:
+			// Flow isn't exposed by the query resource
+			otherPaths = append(otherPaths, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	var buf bytes.Buffer
+	d := &plugin.QueryData{Connection: &plugin.Connection{Config: salesforceConfig{URL: stringPtr(server.URL), AccessToken: stringPtr("token")}}}
+	client, err := connect(contextWithLogger(&buf), d)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	t.Run("follows every page", func(t *testing.T) {
+		ids := []string{}
+		_, err := streamToolingQueryRecords(contextWithLogger(&buf), d, client, "Flow", query, func(record map[string]interface{}) bool {
+			ids = append(ids, record["Id"].(string))
+			return true
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expected := []string{"301a", "301b", "301c"}; !slices.Equal(ids, expected) {
+			t.Errorf("ids = %v, want %v", ids, expected)
+		}
+		if len(queries) != 1 || queries[0] != query || len(otherPaths) != 0 {
+			t.Errorf("tooling queries = %q, other requests = %q, want only %q", queries, otherPaths, query)
+		}
+	})
+
+	t.Run("regular queries still use the query resource", func(t *testing.T) {
+		// The shared client isn't switched to the Tooling API
+		client.Query("SELECT Id FROM Account")
+		if expected := []string{"/services/data/v" + defaultAPIVersion + "/query"}; !slices.Equal(otherPaths, expected) {
+			t.Errorf("requests = %q, want %q", otherPaths, expected)
+		}
+	})
+}
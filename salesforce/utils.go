@@ -1,17 +1,28 @@
 package salesforce
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,6 +34,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/youmark/pkcs8"
 )
 
 const cacheKeyClient = "simpleforce"
@@ -39,19 +51,30 @@ func connectRaw(ctx context.Context, cc *connection.ConnectionCache, c *plugin.C
 	cacheKey := cacheKeyClient
 	if cc != nil {
 		if cachedData, ok := cc.Get(ctx, cacheKey); ok {
-			return cachedData.(*simpleforce.Client), nil
+			// Anything other than a client under the key is ignored and replaced
+			// by a newly authenticated client
+			if client, ok := cachedData.(*simpleforce.Client); ok && client != nil {
+				return client, nil
+			}
+			plugin.Logger(ctx).Warn("connectRaw", "msg", "ignoring unexpected connection cache entry", "type", fmt.Sprintf("%T", cachedData))
 		}
 	}
 
 	config := GetConfig(c)
-	apiVersion := simpleforce.DefaultAPIVersion
+	if err := validateAPIVersion(config); err != nil {
+		return nil, err
+	}
+	apiVersion := getAPIVersion(config)
+	plugin.Logger(ctx).Info("connectRaw", "msg", "connecting", "auth_method", authMethod(config), "api_version", apiVersion)
 	clientID := "steampipe"
 
 	if config.ClientId != nil {
 		clientID = *config.ClientId
 	}
-	if config.APIVersion != nil {
-		apiVersion = *config.APIVersion
+
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
 	}
 
 	// Precedence 1: Pre-obtained access token
@@ -63,7 +86,15 @@ func connectRaw(ctx context.Context, cc *connection.ConnectionCache, c *plugin.C
 		if client == nil {
 			return nil, fmt.Errorf("failed to create salesforce client")
 		}
-		client.SetSidLoc(*config.AccessToken, *config.URL)
+		if err := checkAccessTokenURL(ctx, httpClient, config); err != nil {
+			return nil, err
+		}
+		client.SetHttpClient(httpClient)
+		client.SetSidLoc(normalizeAccessToken(*config.AccessToken), *config.URL)
+
+		if err := checkAPIVersion(ctx, httpClient, client.GetLoc(), config); err != nil {
+			return nil, err
+		}
 
 		if cc != nil {
 			if err := cc.Set(ctx, cacheKey, client); err != nil {
@@ -85,8 +116,8 @@ func connectRaw(ctx context.Context, cc *connection.ConnectionCache, c *plugin.C
 			return nil, fmt.Errorf("refresh_token auth requires 'client_secret' to be set")
 		}
 
-		loginBase := loginURL(*config.URL)
-		accessToken, instanceURL, err := refreshAccessToken(loginBase, clientID, *config.ClientSecret, *config.RefreshToken)
+		loginBase := getLoginURL(config)
+		accessToken, instanceURL, err := refreshAccessToken(httpClient, loginBase, clientID, *config.ClientSecret, *config.RefreshToken)
 		if err != nil {
 			return nil, fmt.Errorf("refresh_token login failed: %v", err)
 		}
@@ -95,8 +126,13 @@ func connectRaw(ctx context.Context, cc *connection.ConnectionCache, c *plugin.C
 		if client == nil {
 			return nil, fmt.Errorf("failed to create salesforce client")
 		}
+		client.SetHttpClient(httpClient)
 		client.SetSidLoc(accessToken, instanceURL)
 
+		if err := checkAPIVersion(ctx, httpClient, client.GetLoc(), config); err != nil {
+			return nil, err
+		}
+
 		if cc != nil {
 			if err := cc.Set(ctx, cacheKey, client); err != nil {
 				plugin.Logger(ctx).Error("connectRaw", "cache-set", err)
@@ -121,9 +157,21 @@ func connectRaw(ctx context.Context, cc *connection.ConnectionCache, c *plugin.C
 		if err != nil {
 			return nil, err
 		}
+		passphrase := ""
+		if config.PrivateKeyPassphrase != nil {
+			passphrase = *config.PrivateKeyPassphrase
+		}
+		pemKey, err = decryptPrivateKey(pemKey, passphrase)
+		if err != nil {
+			return nil, err
+		}
 
-		loginBase := loginURL(*config.URL)
-		accessToken, instanceURL, err := loginJWT(loginBase, clientID, *config.Username, pemKey)
+		loginBase := getLoginURL(config)
+		audience := ""
+		if config.JWTAudience != nil {
+			audience = strings.TrimSpace(*config.JWTAudience)
+		}
+		accessToken, instanceURL, err := loginJWT(httpClient, loginBase, audience, clientID, *config.Username, pemKey)
 		if err != nil {
 			return nil, fmt.Errorf("jwt login failed: %v", err)
 		}
@@ -132,8 +180,13 @@ func connectRaw(ctx context.Context, cc *connection.ConnectionCache, c *plugin.C
 		if client == nil {
 			return nil, fmt.Errorf("failed to create salesforce client")
 		}
+		client.SetHttpClient(httpClient)
 		client.SetSidLoc(accessToken, instanceURL)
 
+		if err := checkAPIVersion(ctx, httpClient, client.GetLoc(), config); err != nil {
+			return nil, err
+		}
+
 		if cc != nil {
 			if err := cc.Set(ctx, cacheKey, client); err != nil {
 				plugin.Logger(ctx).Error("connectRaw", "cache-set", err)
@@ -159,15 +212,20 @@ func connectRaw(ctx context.Context, cc *connection.ConnectionCache, c *plugin.C
 		if client == nil {
 			return nil, fmt.Errorf("failed to create salesforce client")
 		}
+		client.SetHttpClient(httpClient)
 
 		// LoginPassword signs into salesforce using password. token is optional if trusted IP is configured.
 		// Ref: https://developer.salesforce.com/docs/atlas.en-us.214.0.api_rest.meta/api_rest/intro_understanding_username_password_oauth_flow.htm
 		// Ref: https://developer.salesforce.com/docs/atlas.en-us.214.0.api.meta/api/sforce_api_calls_login.htm
-		err := client.LoginPassword(*config.Username, *config.Password, securityToken)
+		err = client.LoginPassword(*config.Username, *config.Password, securityToken)
 		if err != nil {
 			return nil, fmt.Errorf("password login failed: %v", err)
 		}
 
+		if err := checkAPIVersion(ctx, httpClient, client.GetLoc(), config); err != nil {
+			return nil, err
+		}
+
 		if cc != nil {
 			if err := cc.Set(ctx, cacheKey, client); err != nil {
 				plugin.Logger(ctx).Error("connectRaw", "cache-set", err)
@@ -179,18 +237,469 @@ func connectRaw(ctx context.Context, cc *connection.ConnectionCache, c *plugin.C
 	return nil, fmt.Errorf("no valid authentication credentials configured; provide access_token, refresh_token, private_key/private_key_file, or username/password")
 }
 
+// normalizeAccessToken strips surrounding whitespace and a leading "Bearer "
+// prefix (in any case), which is often pasted along with the token from an
+// Authorization header.
+func normalizeAccessToken(token string) string {
+	token = strings.TrimSpace(token)
+	if len(token) > len("bearer ") && strings.EqualFold(token[:len("bearer ")], "bearer ") {
+		token = strings.TrimSpace(token[len("bearer "):])
+	}
+	return token
+}
+
+// newHTTPClient returns the HTTP client used for all Salesforce API calls. It
+// routes requests through proxy_url if set, otherwise through the proxy from
+// the HTTPS_PROXY/HTTP_PROXY/NO_PROXY environment variables.
+func newHTTPClient(config salesforceConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	if config.ProxyURL != nil && *config.ProxyURL != "" {
+		proxyURL, err := url.Parse(*config.ProxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy_url %q: must be an absolute URL such as http://proxy.example.com:8080", *config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	return &http.Client{Transport: newRetryTransport(transport, config), Timeout: queryTimeout(config)}, nil
+}
+
+// defaultQueryTimeout is used when query_timeout_seconds isn't set.
+const defaultQueryTimeout = 2 * time.Minute
+
+// queryTimeout returns how long an API request can take, including its
+// retries, or 0 if query_timeout_seconds disables the timeout. simpleforce
+// doesn't take a context, so the timeout is set on the HTTP client.
+func queryTimeout(config salesforceConfig) time.Duration {
+	if config.QueryTimeoutSeconds == nil {
+		return defaultQueryTimeout
+	}
+	return time.Duration(max(*config.QueryTimeoutSeconds, 0)) * time.Second
+}
+
+// queryTimeoutError returns err explaining that the request timed out after
+// query_timeout_seconds if it did, so it isn't mistaken for a network or
+// authentication failure. Other errors are returned as they are.
+func queryTimeoutError(err error, config salesforceConfig) error {
+	var netErr net.Error
+	if err == nil || !errors.As(err, &netErr) || !netErr.Timeout() {
+		return err
+	}
+	return fmt.Errorf("salesforce request timed out after %s, the query_timeout_seconds of the connection: %w", queryTimeout(config), err)
+}
+
+const (
+	// defaultMaxRetries and defaultRetryBaseDelay are used when max_retries and
+	// retry_base_delay_ms are not configured.
+	defaultMaxRetries     = 3
+	defaultRetryBaseDelay = 500 * time.Millisecond
+
+	// maxRetryDelay caps the wait before a retry, including one asked for by a
+	// Retry-After header.
+	maxRetryDelay = time.Minute
+)
+
+// retryTransport retries requests that Salesforce rejects because of rate
+// limiting (429, or 403 with REQUEST_LIMIT_EXCEEDED) or a transient server
+// error (5xx), waiting with exponential backoff and jitter, or as long as the
+// Retry-After header asks. Any other response, such as an INVALID_FIELD
+// error, is returned at once. Retrying in the transport covers every API
+// call, including describes, whose errors simpleforce doesn't return.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	baseDelay  time.Duration
+}
+
+// newRetryTransport wraps next with the max_retries and retry_base_delay_ms of
+// the connection config.
+func newRetryTransport(next http.RoundTripper, config salesforceConfig) *retryTransport {
+	t := &retryTransport{next: next, maxRetries: defaultMaxRetries, baseDelay: defaultRetryBaseDelay}
+	if config.MaxRetries != nil {
+		t.maxRetries = max(*config.MaxRetries, 0)
+	}
+	if config.RetryBaseDelayMs != nil {
+		t.baseDelay = time.Duration(max(*config.RetryBaseDelayMs, 0)) * time.Millisecond
+	}
+	return t
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || !isRetryableResponse(resp) || attempt > t.maxRetries {
+			return resp, err
+		}
+		// The request can only be sent again if its body can be recreated
+		if req.Body != nil && req.GetBody == nil {
+			return resp, nil
+		}
+
+		delay := t.retryDelay(attempt, resp.Header.Get("Retry-After"))
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// retryDelay returns how long to wait before the given retry attempt (starting
+// at 1): what the Retry-After header asks for if there is one, otherwise the
+// base delay doubled after each attempt, randomly shortened by up to half so
+// that concurrent queries don't retry in lockstep.
+func (t *retryTransport) retryDelay(attempt int, retryAfter string) time.Duration {
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, maxRetryDelay)
+	}
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		return min(max(time.Until(date), 0), maxRetryDelay)
+	}
+
+	// Cap the doubling so the delay can't overflow
+	delay := min(t.baseDelay<<min(attempt-1, 10), maxRetryDelay)
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// isRetryableStatus returns true for the HTTP statuses of responses that may
+// succeed if the request is sent again later.
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isRetryableResponse returns true for responses with a retryable status, and
+// for 403 responses whose error is REQUEST_LIMIT_EXCEEDED, which is how
+// Salesforce reports that the org has exceeded an API request limit. The body
+// of a 403 response is read to find its error, and replaced so it can still
+// be read by the caller.
+func isRetryableResponse(resp *http.Response) bool {
+	if isRetryableStatus(resp.StatusCode) {
+		return true
+	}
+	if resp.StatusCode != http.StatusForbidden {
+		return false
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return false
+	}
+	errs := []struct {
+		ErrorCode string `json:"errorCode"`
+	}{}
+	if json.Unmarshal(data, &errs) != nil {
+		return false
+	}
+	for _, e := range errs {
+		if e.ErrorCode == "REQUEST_LIMIT_EXCEEDED" {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultAPIVersion is the API version used when api_version isn't set. It is
+// set here rather than taken from simpleforce.DefaultAPIVersion, which is
+// 43.0 (Summer '18), so fields and objects added since are described.
+const defaultAPIVersion = "62.0"
+
+// getAPIVersion returns the configured api_version, falling back to
+// defaultAPIVersion when unset. Surrounding whitespace and a "v" prefix, as
+// in the version's URL path segment, are removed.
+func getAPIVersion(config salesforceConfig) string {
+	if config.APIVersion != nil {
+		if version := strings.TrimPrefix(strings.TrimSpace(*config.APIVersion), "v"); version != "" {
+			return version
+		}
+	}
+	return defaultAPIVersion
+}
+
+// apiVersionPattern matches the API versions Salesforce publishes, e.g. 62.0.
+var apiVersionPattern = regexp.MustCompile(`^[0-9]+\.0$`)
+
+// validateAPIVersion returns an error if api_version is set to something that
+// isn't an API version, such as "v60" or "Winter '25", which otherwise fails
+// every request with a 404 that doesn't say why.
+func validateAPIVersion(config salesforceConfig) error {
+	if config.APIVersion == nil {
+		return nil
+	}
+	if !apiVersionPattern.MatchString(getAPIVersion(config)) {
+		return fmt.Errorf("invalid api_version %q: must be a version number such as %q", *config.APIVersion, defaultAPIVersion)
+	}
+	return nil
+}
+
+// checkAPIVersion returns an error listing the API versions of the org if the
+// configured api_version isn't one of them. The versions are read from the
+// /services/data resource of the instance, which doesn't need authentication.
+// Failures to read them are logged and ignored, and the default version isn't
+// checked, so the check costs a request only when api_version is set.
+// Ref: https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_versions.htm
+func checkAPIVersion(ctx context.Context, httpClient *http.Client, instanceURL string, config salesforceConfig) error {
+	if config.APIVersion == nil || strings.TrimSpace(*config.APIVersion) == "" {
+		return nil
+	}
+	version := getAPIVersion(config)
+	versions, err := availableAPIVersions(httpClient, instanceURL)
+	if err != nil {
+		plugin.Logger(ctx).Warn("checkAPIVersion", "msg", "unable to list the API versions of the org, skipping check", "error", err)
+		return nil
+	}
+	if len(versions) == 0 || slices.Contains(versions, version) {
+		return nil
+	}
+	return fmt.Errorf("api_version %q is not supported by %s; available versions: %s", version, instanceURL, strings.Join(versions, ", "))
+}
+
+// availableAPIVersions returns the API versions an instance supports, oldest
+// first.
+func availableAPIVersions(httpClient *http.Client, instanceURL string) ([]string, error) {
+	resp, err := httpClient.Get(strings.TrimSuffix(instanceURL, "/") + "/services/data")
+	if err != nil {
+		return nil, fmt.Errorf("versions request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read versions response: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("versions request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var result []struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse versions response: %v", err)
+	}
+	versions := []string{}
+	for _, v := range result {
+		versions = append(versions, v.Version)
+	}
+	return versions, nil
+}
+
+// defaultBulkThresholdRows is the bulk_threshold_rows used when query_api is
+// "bulk" and no threshold is configured.
+const defaultBulkThresholdRows = 10000
+
+// queryAPIForTotalSize picks the API used to extract a query's results from its
+// estimated totalSize. Bulk is only chosen when the estimate exceeds
+// bulk_threshold_rows, which defaults to defaultBulkThresholdRows when
+// query_api is "bulk" and is otherwise unset; everything else stays on REST.
+// query_api = "rest" always uses REST.
+func queryAPIForTotalSize(config salesforceConfig, totalSize int) QueryAPIEnum {
+	if config.QueryAPI != nil && *config.QueryAPI == QUERY_API_REST {
+		return QUERY_API_REST
+	}
+	threshold := 0
+	if config.BulkThresholdRows != nil {
+		threshold = *config.BulkThresholdRows
+	} else if config.QueryAPI != nil && *config.QueryAPI == QUERY_API_BULK {
+		threshold = defaultBulkThresholdRows
+	}
+	if threshold <= 0 || totalSize <= threshold {
+		return QUERY_API_REST
+	}
+	return QUERY_API_BULK
+}
+
 // generateQuery:: returns sql query based on the column names, table name passed
 func generateQuery(columns []*plugin.Column, tableName string) string {
 	var queryColumns []string
 	for _, column := range columns {
-		if column.Name != "OrganizationId" && column.Name != "organization_id" {
-			queryColumns = append(queryColumns, getSalesforceColumnName(column.Name))
+		// Columns with a hydrate function of their own, such as organization_id,
+		// aren't fields of the object
+		if column.Hydrate != nil || column.Name == "OrganizationId" || column.Name == "organization_id" {
+			continue
 		}
+		queryColumns = append(queryColumns, salesforceFieldName(column))
 	}
 
 	return fmt.Sprintf("SELECT %s FROM %s", strings.Join(queryColumns, ", "), tableName)
 }
 
+// buildOrderByFromSortOrder returns the SOQL ORDER BY fields for the sort order
+// Steampipe pushed down, or an empty string if any of its columns is not
+// sortable in Salesforce, in which case Postgres sorts the rows itself. The
+// fields needn't be in the SELECT, so relationship columns left out by
+// queryColumns can still be sorted on.
+//
+// Postgres sorts nulls last in ascending order and first in descending order,
+// while SOQL defaults to nulls first, so the nulls position is always explicit.
+func buildOrderByFromSortOrder(sortOrder []*plugin.SortColumn, tableColumns []*plugin.Column) string {
+	fields := []string{}
+	for _, sortColumn := range sortOrder {
+		var column *plugin.Column
+		for _, c := range tableColumns {
+			if c.Name == sortColumn.Column {
+				column = c
+				break
+			}
+		}
+		if column == nil || column.Sort == plugin.SortNone {
+			return ""
+		}
+
+		fieldName := salesforceFieldName(column)
+		switch sortColumn.Order {
+		case plugin.SortAsc:
+			fields = append(fields, fieldName+" ASC NULLS LAST")
+		case plugin.SortDesc:
+			fields = append(fields, fieldName+" DESC NULLS FIRST")
+		default:
+			return ""
+		}
+	}
+	return strings.Join(fields, ", ")
+}
+
+// withQueryLimit appends the LIMIT Steampipe pushed down to query. The limit
+// is only safe when every row Salesforce returns is also returned by Postgres,
+// so it is skipped when:
+//   - a sort order was requested but could not be pushed down (orderBy is empty),
+//     since the first rows would not be the ones Postgres keeps
+//   - a string column is filtered, since SOQL compares strings case-insensitively
+//     and Postgres filters out some of the rows again
+//   - a qual is on a column of a type that isn't pushed down exactly
+//   - a numeric column is filtered with <> or NOT IN, since SOQL != also
+//     matches nulls, which Postgres filters out again
+//   - a timestamp qual is sent as a SOQL date literal, which matches more rows
+func withQueryLimit(query string, queryContext *plugin.QueryContext, orderBy string, quals plugin.KeyColumnQualMap, tableColumns []*plugin.Column, config salesforceConfig) string {
+	limit := queryContext.GetLimit()
+	if limit < 0 {
+		return query
+	}
+	if len(queryContext.SortOrder) > 0 && orderBy == "" {
+		return query
+	}
+
+	for _, columnQuals := range quals {
+		var column *plugin.Column
+		for _, c := range tableColumns {
+			if c.Name == columnQuals.Name {
+				column = c
+				break
+			}
+		}
+		for _, qual := range columnQuals.Quals {
+			if qual.Operator == "is null" || qual.Operator == "is not null" {
+				continue
+			}
+			if column == nil {
+				return query
+			}
+			switch column.Type {
+			case proto.ColumnType_BOOL:
+			case proto.ColumnType_INT, proto.ColumnType_DOUBLE:
+				// NOT IN is also a <> qual, with a list value
+				if qual.Operator == "<>" {
+					return query
+				}
+			case proto.ColumnType_TIMESTAMP:
+				if config.RelativeDateLiterals != nil && *config.RelativeDateLiterals && qual.Value.GetTimestampValue() != nil {
+					if _, ok := soqlDateLiteralFilter(qual.Operator, qual.Value.GetTimestampValue().AsTime(), time.Now()); ok {
+						return query
+					}
+				}
+			default:
+				return query
+			}
+		}
+	}
+
+	return fmt.Sprintf("%s limit %d", query, limit)
+}
+
+// countOnlyRecord returns the record to stream for every row of a scan that
+// only counts rows, such as select count(*), and true, or false if the scan
+// reads field values. Postgres rechecks quals against the returned rows, so
+// the columns of exact equality quals may be requested; the record carries the
+// qual values for them. Columns the SDK adds, like sp_ctx, are ignored.
+func countOnlyRecord(requestedColumns []string, quals plugin.KeyColumnQualMap, tableColumns []*plugin.Column) (map[string]interface{}, bool) {
+	record := map[string]interface{}{}
+	for _, name := range requestedColumns {
+		var column *plugin.Column
+		for _, c := range tableColumns {
+			if c.Name == name {
+				column = c
+				break
+			}
+		}
+		if column == nil {
+			continue
+		}
+		if column.Hydrate != nil {
+			return nil, false
+		}
+		if _, ok := isRelationshipColumn(column); ok {
+			return nil, false
+		}
+
+		columnQuals, ok := quals[name]
+		if !ok || len(columnQuals.Quals) != 1 || columnQuals.Quals[0].Operator != "=" {
+			return nil, false
+		}
+		// Strings and timestamps are compared differently by SOQL, so their
+		// values can't be assumed to equal the qual's
+		switch value := columnQuals.Quals[0].Value.GetValue().(type) {
+		case *proto.QualValue_BoolValue:
+			if column.Type != proto.ColumnType_BOOL {
+				return nil, false
+			}
+			record[recordFieldName(column)] = value.BoolValue
+		case *proto.QualValue_Int64Value:
+			if column.Type != proto.ColumnType_INT && column.Type != proto.ColumnType_DOUBLE {
+				return nil, false
+			}
+			record[recordFieldName(column)] = value.Int64Value
+		case *proto.QualValue_DoubleValue:
+			if column.Type != proto.ColumnType_DOUBLE {
+				return nil, false
+			}
+			record[recordFieldName(column)] = value.DoubleValue
+		default:
+			return nil, false
+		}
+	}
+	return record, true
+}
+
+// recordFieldName returns the key of a column's field in query records: the
+// field name dynamic columns are read with, or the field named after the
+// column.
+func recordFieldName(column *plugin.Column) string {
+	if column.Transform != nil && len(column.Transform.Transforms) > 0 {
+		if fieldName, ok := column.Transform.Transforms[0].Param.(string); ok {
+			return fieldName
+		}
+	}
+	return getSalesforceColumnName(column.Name)
+}
+
 // decodeQueryResult(ctx, apiResponse, responseStruct):: converts raw apiResponse to required output struct
 func decodeQueryResult(ctx context.Context, response interface{}, respObject interface{}) error {
 	resp, err := json.Marshal(response)
@@ -209,9 +718,17 @@ func decodeQueryResult(ctx context.Context, response interface{}, respObject int
 }
 
 // buildQueryFromQuals :: generate api_native based on the contions specified in sql query
+//
+// Filters are always joined with AND: the quals Steampipe passes to a plugin
+// are conjunctive, and an OR in the SQL WHERE clause is evaluated by Postgres
+// rather than being split into separate quals. An OR on a single column, such as
+// `stage_name = 'Closed Won' OR stage_name = 'Closed Lost'`, is pushed down when
+// written as `stage_name in ('Closed Won', 'Closed Lost')`, which arrives as a
+// single list qual and becomes a SOQL IN.
+//
 // refrences
 // - https://developer.salesforce.com/docs/atlas.en-us.234.0.soql_sosl.meta/soql_sosl/sforce_api_calls_soql_select_comparisonoperators.htm
-func buildQueryFromQuals(equalQuals plugin.KeyColumnQualMap, tableColumns []*plugin.Column, salesforceCols map[string]string) string {
+func buildQueryFromQuals(equalQuals plugin.KeyColumnQualMap, tableColumns []*plugin.Column, salesforceCols map[string]string, config salesforceConfig) string {
 	filters := []string{}
 
 	for _, filterQualItem := range tableColumns {
@@ -227,47 +744,67 @@ func buildQueryFromQuals(equalQuals plugin.KeyColumnQualMap, tableColumns []*plu
 			}
 
 			for _, qual := range filterQual.Quals {
+				// Null checks carry no value and are the same for every field type
+				switch qual.Operator {
+				case "is null":
+					filters = append(filters, fmt.Sprintf("%s = null", getSalesforceColumnName(filterQualItem.Name)))
+					continue
+				case "is not null":
+					filters = append(filters, fmt.Sprintf("%s != null", getSalesforceColumnName(filterQualItem.Name)))
+					continue
+				}
 				if qual.Value != nil {
 					value := qual.Value
 					switch filterQualItem.Type {
 					case proto.ColumnType_STRING:
 						// In case of IN caluse
 						if value.GetListValue() != nil {
-							// continue
-							switch qual.Operator {
-							case "=":
-								stringValueSlice := []string{}
-								for _, q := range value.GetListValue().Values {
-									stringValueSlice = append(stringValueSlice, q.GetStringValue())
-								}
-								if len(stringValueSlice) > 0 {
-									filters = append(filters, fmt.Sprintf("%s IN ('%s')", getSalesforceColumnName(filterQualItem.Name), strings.Join(stringValueSlice, "','")))
-								}
-							case "<>":
-								stringValueSlice := []string{}
-								for _, q := range value.GetListValue().Values {
-									stringValueSlice = append(stringValueSlice, q.GetStringValue())
-								}
-								if len(stringValueSlice) > 0 {
-									filters = append(filters, fmt.Sprintf("%s NOT IN ('%s')", getSalesforceColumnName(filterQualItem.Name), strings.Join(stringValueSlice, "','")))
-								}
+							stringValueSlice := []string{}
+							for _, q := range value.GetListValue().Values {
+								stringValueSlice = append(stringValueSlice, fmt.Sprintf("'%s'", escapeSOQLString(q.GetStringValue())))
+							}
+							if filter := buildListFilter(getSalesforceColumnName(filterQualItem.Name), qual.Operator, stringValueSlice); filter != "" {
+								filters = append(filters, filter)
 							}
 						} else {
 							switch qual.Operator {
 							case "=":
-								filters = append(filters, fmt.Sprintf("%s = '%s'", getSalesforceColumnName(filterQualItem.Name), value.GetStringValue()))
+								filters = append(filters, fmt.Sprintf("%s = '%s'", getSalesforceColumnName(filterQualItem.Name), escapeSOQLString(value.GetStringValue())))
 							case "<>":
-								filters = append(filters, fmt.Sprintf("%s != '%s'", getSalesforceColumnName(filterQualItem.Name), value.GetStringValue()))
+								filters = append(filters, fmt.Sprintf("%s != '%s'", getSalesforceColumnName(filterQualItem.Name), escapeSOQLString(value.GetStringValue())))
+							case ">", ">=", "<", "<=":
+								filters = append(filters, fmt.Sprintf("%s %s '%s'", getSalesforceColumnName(filterQualItem.Name), qual.Operator, escapeSOQLString(value.GetStringValue())))
+							// SOQL LIKE is always case-insensitive, so LIKE may return extra
+							// rows, which Postgres filters out again
+							case "~~", "~~*":
+								filters = append(filters, fmt.Sprintf("%s LIKE '%s'", getSalesforceColumnName(filterQualItem.Name), likePatternToSOQL(value.GetStringValue())))
 							}
 						}
 					case proto.ColumnType_BOOL:
+						// Checkbox fields only register = and <>, so any other
+						// operator, or a value that isn't a boolean, is left to Postgres
+						boolValue, ok := value.Value.(*proto.QualValue_BoolValue)
+						if !ok {
+							continue
+						}
 						switch qual.Operator {
-						case "<>":
-							filters = append(filters, fmt.Sprintf("%s = %s", getSalesforceColumnName(filterQualItem.Name), "FALSE"))
 						case "=":
-							filters = append(filters, fmt.Sprintf("%s = %s", getSalesforceColumnName(filterQualItem.Name), "TRUE"))
+							filters = append(filters, fmt.Sprintf("%s = %s", getSalesforceColumnName(filterQualItem.Name), soqlBooleanLiteral(boolValue.BoolValue, config)))
+						case "<>":
+							filters = append(filters, fmt.Sprintf("%s = %s", getSalesforceColumnName(filterQualItem.Name), soqlBooleanLiteral(!boolValue.BoolValue, config)))
 						}
 					case proto.ColumnType_INT:
+						// In case of IN/NOT IN clause
+						if value.GetListValue() != nil {
+							intValueSlice := []string{}
+							for _, q := range value.GetListValue().Values {
+								intValueSlice = append(intValueSlice, fmt.Sprintf("%d", q.GetInt64Value()))
+							}
+							if filter := buildListFilter(getSalesforceColumnName(filterQualItem.Name), qual.Operator, intValueSlice); filter != "" {
+								filters = append(filters, filter)
+							}
+							continue
+						}
 						switch qual.Operator {
 						case "<>":
 							filters = append(filters, fmt.Sprintf("%s != %d", getSalesforceColumnName(filterQualItem.Name), value.GetInt64Value()))
@@ -275,26 +812,47 @@ func buildQueryFromQuals(equalQuals plugin.KeyColumnQualMap, tableColumns []*plu
 							filters = append(filters, fmt.Sprintf("%s %s %d", getSalesforceColumnName(filterQualItem.Name), qual.Operator, value.GetInt64Value()))
 						}
 					case proto.ColumnType_DOUBLE:
+						// In case of IN/NOT IN clause
+						if value.GetListValue() != nil {
+							doubleValueSlice := []string{}
+							for _, q := range value.GetListValue().Values {
+								doubleValueSlice = append(doubleValueSlice, formatSOQLDouble(q.GetDoubleValue()))
+							}
+							if filter := buildListFilter(getSalesforceColumnName(filterQualItem.Name), qual.Operator, doubleValueSlice); filter != "" {
+								filters = append(filters, filter)
+							}
+							continue
+						}
 						switch qual.Operator {
 						case "<>":
-							filters = append(filters, fmt.Sprintf("%s != %f", getSalesforceColumnName(filterQualItem.Name), value.GetDoubleValue()))
+							filters = append(filters, fmt.Sprintf("%s != %s", getSalesforceColumnName(filterQualItem.Name), formatSOQLDouble(value.GetDoubleValue())))
 						default:
-							filters = append(filters, fmt.Sprintf("%s %s %f", getSalesforceColumnName(filterQualItem.Name), qual.Operator, value.GetDoubleValue()))
+							filters = append(filters, fmt.Sprintf("%s %s %s", getSalesforceColumnName(filterQualItem.Name), qual.Operator, formatSOQLDouble(value.GetDoubleValue())))
 						}
 					// Need a way to distinguish b/w date and dateTime fields
 					case proto.ColumnType_TIMESTAMP:
 						// https://developer.salesforce.com/docs/atlas.en-us.234.0.soql_sosl.meta/soql_sosl/sforce_api_calls_soql_select_dateformats.htm
-						if salesforceCols[filterQual.Name] == "date" {
-							switch qual.Operator {
-							case "=", ">=", ">", "<=", "<":
-								filters = append(filters, fmt.Sprintf("%s %s %s", getSalesforceColumnName(filterQualItem.Name), qual.Operator, value.GetTimestampValue().AsTime().Format("2006-01-02")))
+						// In case of IN clause
+						if value.GetListValue() != nil {
+							timestampValueSlice := []string{}
+							for _, q := range value.GetListValue().Values {
+								timestampValueSlice = append(timestampValueSlice, formatSOQLTimestamp(salesforceCols[filterQual.Name], qual.Operator, q.GetTimestampValue().AsTime()))
 							}
-						} else {
-							switch qual.Operator {
-							case "=", ">=", ">", "<=", "<":
-								filters = append(filters, fmt.Sprintf("%s %s %s", getSalesforceColumnName(filterQualItem.Name), qual.Operator, value.GetTimestampValue().AsTime().Format("2006-01-02T15:04:05Z")))
+							if filter := buildListFilter(getSalesforceColumnName(filterQualItem.Name), qual.Operator, timestampValueSlice); filter != "" {
+								filters = append(filters, filter)
+							}
+							continue
+						}
+						if config.RelativeDateLiterals != nil && *config.RelativeDateLiterals {
+							if filter, ok := soqlDateLiteralFilter(qual.Operator, value.GetTimestampValue().AsTime(), time.Now()); ok {
+								filters = append(filters, fmt.Sprintf("%s %s", getSalesforceColumnName(filterQualItem.Name), filter))
+								continue
 							}
 						}
+						switch qual.Operator {
+						case "=", ">=", ">", "<=", "<":
+							filters = append(filters, fmt.Sprintf("%s %s %s", getSalesforceColumnName(filterQualItem.Name), qual.Operator, formatSOQLTimestamp(salesforceCols[filterQual.Name], qual.Operator, value.GetTimestampValue().AsTime())))
+						}
 					}
 				}
 			}
@@ -309,11 +867,133 @@ func buildQueryFromQuals(equalQuals plugin.KeyColumnQualMap, tableColumns []*plu
 	return ""
 }
 
+// relativeDateTolerance is how far a timestamp qual may be from an exact
+// number of days before now and still be treated as a relative bound, to
+// allow for the time between Postgres evaluating now() and the plugin.
+const relativeDateTolerance = time.Minute
+
+// soqlDateLiteralFilter translates a timestamp lower bound of a whole number
+// of days before now into a comparison with a SOQL date literal:
+//
+//	> or >= now() - interval 'N days' becomes >= LAST_N_DAYS:N
+//
+// LAST_N_DAYS:N starts at midnight, in the org's time zone, of the day N days
+// ago, which is never later than the bound, so no row matching the qual is
+// filtered out. Postgres still applies the original qual to the rows returned.
+// Literals such as TODAY or THIS_MONTH aren't used, since the org's day or
+// month can start after the bound.
+// Returns false if value isn't a recognized relative bound.
+func soqlDateLiteralFilter(operator string, value time.Time, now time.Time) (string, bool) {
+	switch operator {
+	case ">", ">=":
+		ago := now.Sub(value)
+		days := ago.Round(24 * time.Hour)
+		if days >= 24*time.Hour && (ago-days).Abs() <= relativeDateTolerance {
+			return fmt.Sprintf(">= LAST_N_DAYS:%d", int(days/(24*time.Hour))), true
+		}
+	}
+	return "", false
+}
+
+// formatSOQLDateBound formats a timestamp qual on a date field as a SOQL date.
+// Date values are returned as midnight UTC, which is also how Postgres reads
+// a date such as '2024-06-20' in a UTC session. A bound later in the day, e.g.
+// from a session in UTC+10, is only reached by the next date, so for >= and <
+// the next date is used rather than the UTC date the bound falls on.
+func formatSOQLDateBound(operator string, value time.Time) string {
+	value = value.UTC()
+	if !value.Equal(value.Truncate(24*time.Hour)) && (operator == ">=" || operator == "<") {
+		value = value.AddDate(0, 0, 1)
+	}
+	return value.Format("2006-01-02")
+}
+
+// formatSOQLTimestamp formats a timestamp qual value as a SOQL date if the
+// field's type is date, see formatSOQLDateBound, or as a SOQL dateTime in UTC.
+func formatSOQLTimestamp(fieldType string, operator string, value time.Time) string {
+	if fieldType == "date" {
+		return formatSOQLDateBound(operator, value)
+	}
+	return value.UTC().Format("2006-01-02T15:04:05Z")
+}
+
+// buildListFilter renders an IN (for "=") or NOT IN (for "<>") clause from
+// already formatted SOQL literals. Returns "" for an empty list or any other
+// operator.
+func buildListFilter(columnName string, operator string, values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	switch operator {
+	case "=":
+		return fmt.Sprintf("%s IN (%s)", columnName, strings.Join(values, ","))
+	case "<>":
+		return fmt.Sprintf("%s NOT IN (%s)", columnName, strings.Join(values, ","))
+	}
+	return ""
+}
+
+// formatSOQLDouble renders v with the fewest digits that round-trip, e.g. 99.5
+// rather than 99.500000. Exponent notation is avoided since SOQL number
+// literals don't support it, so 1e-7 becomes 0.0000001.
+func formatSOQLDouble(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// soqlBooleanLiteral renders value as a SOQL boolean literal. SOQL accepts
+// either case; boolean_literal_case picks the one written, defaulting to upper.
+func soqlBooleanLiteral(value bool, config salesforceConfig) string {
+	literal := "TRUE"
+	if !value {
+		literal = "FALSE"
+	}
+	if config.BooleanLiteralCase != nil && *config.BooleanLiteralCase == BOOLEAN_LITERAL_LOWER {
+		return strings.ToLower(literal)
+	}
+	return literal
+}
+
+// soqlStringEscaper backslash-escapes the characters that can't appear as is
+// in a SOQL string literal.
+// https://developer.salesforce.com/docs/atlas.en-us.soql_sosl.meta/soql_sosl/sforce_api_calls_soql_select_quotedstringescapes.htm
+var soqlStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+
+// escapeSOQLString escapes value for use inside a single-quoted SOQL string
+// literal, so quotes in qual values can't break or extend the query.
+func escapeSOQLString(value string) string {
+	return soqlStringEscaper.Replace(value)
+}
+
+// likePatternToSOQL converts a Postgres LIKE pattern into the body of a SOQL
+// LIKE string literal. The % and _ wildcards are the same in both, and
+// backslash escapes of wildcards are kept. Everything else is escaped with
+// escapeSOQLString.
+// https://developer.salesforce.com/docs/atlas.en-us.soql_sosl.meta/soql_sosl/sforce_api_calls_soql_select_comparisonoperators.htm
+func likePatternToSOQL(pattern string) string {
+	var b strings.Builder
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		// In Postgres a backslash makes the next character literal
+		if r == '\\' && i+1 < len(runes) {
+			i++
+			r = runes[i]
+			if r == '%' || r == '_' {
+				b.WriteRune('\\')
+				b.WriteRune(r)
+				continue
+			}
+		}
+		b.WriteString(escapeSOQLString(string(r)))
+	}
+	return b.String()
+}
+
 func getSalesforceColumnName(name string) string {
 	var columnName string
 	// Salesforce custom fields are suffixed with '__c' and are not converted to
 	// snake case in the table schema, so use the column name as is
-	if strings.HasSuffix(name, "__c") {
+	if isCustomFieldName(name) {
 		columnName = name
 	} else {
 		columnName = strcase.ToCamel(name)
@@ -321,8 +1001,16 @@ func getSalesforceColumnName(name string) string {
 	return columnName
 }
 
-func mergeTableColumns(_ context.Context, config salesforceConfig, dynamicColumns []*plugin.Column, staticColumns []*plugin.Column) []*plugin.Column {
+// isCustomFieldName returns true for the names of custom fields, suffixed with
+// '__c', and of the components of custom geolocation fields, suffixed with
+// '__s', e.g. Location__Latitude__s.
+func isCustomFieldName(name string) bool {
+	return strings.HasSuffix(name, "__c") || strings.HasSuffix(name, "__s")
+}
+
+func mergeTableColumns(ctx context.Context, config salesforceConfig, dm dynamicMap, staticColumns []*plugin.Column) []*plugin.Column {
 	var columns []*plugin.Column
+	dynamicColumns := dm.cols
 
 	// when NamingConvention is set to api_native, do not add the static columns
 	if config.NamingConvention != nil && *config.NamingConvention == "api_native" && len(dynamicColumns) > 0 {
@@ -330,7 +1018,28 @@ func mergeTableColumns(_ context.Context, config salesforceConfig, dynamicColumn
 		return columns
 	}
 
-	columns = append(columns, staticColumns...)
+	dropped := []string{}
+	for _, col := range staticColumns {
+		// Selecting a field the user can't read, or that the org doesn't have,
+		// fails the whole query
+		if dm.readableFields != nil && !dm.readableFields[strings.ToLower(getSalesforceColumnName(col.Name))] {
+			dropped = append(dropped, col.Name)
+			continue
+		}
+		// Static columns are sortable when the field they read is
+		if col.Type != proto.ColumnType_JSON {
+			for _, dynamicCol := range dynamicColumns {
+				if dynamicCol.Name == col.Name {
+					col.Sort = dynamicCol.Sort
+					break
+				}
+			}
+		}
+		columns = append(columns, col)
+	}
+	if len(dropped) > 0 {
+		plugin.Logger(ctx).Debug("salesforce.mergeTableColumns", "msg", "skipping static columns of fields that aren't described as readable", "column_names", strings.Join(dropped, ","))
+	}
 	for _, col := range dynamicColumns {
 		if isColumnAvailable(col.Name, staticColumns) {
 			continue
@@ -341,12 +1050,115 @@ func mergeTableColumns(_ context.Context, config salesforceConfig, dynamicColumn
 	return columns
 }
 
-// dynamicColumns:: Returns list coulms for a salesforce object
-func dynamicColumns(ctx context.Context, client *simpleforce.Client, salesforceTableName string, config salesforceConfig) ([]*plugin.Column, plugin.KeyColumnSlice, map[string]string) {
-	sObjectMeta := client.SObject(salesforceTableName).Describe()
+// requireKeyColumn returns keyColumns with the key column name required and
+// restricted to "=", so every scan of the table is filtered by the column's
+// value. The key column is added if it isn't one of keyColumns, e.g. when the
+// object couldn't be described.
+func requireKeyColumn(keyColumns plugin.KeyColumnSlice, name string) plugin.KeyColumnSlice {
+	required := plugin.KeyColumnSlice{{Name: name, Require: plugin.Required, Operators: []string{"="}}}
+	for _, keyColumn := range keyColumns {
+		if keyColumn.Name != name {
+			required = append(required, keyColumn)
+		}
+	}
+	return required
+}
+
+// defaultDescribeCacheTTL is how long describe results are cached when
+// describe_cache_ttl_seconds isn't set.
+const defaultDescribeCacheTTL = time.Hour
+
+// describeCacheTTL returns how long describe results are cached for, or 0 if
+// describe_cache_ttl_seconds disables caching.
+func describeCacheTTL(config salesforceConfig) time.Duration {
+	if config.DescribeCacheTTLSeconds == nil {
+		return defaultDescribeCacheTTL
+	}
+	return time.Duration(max(*config.DescribeCacheTTLSeconds, 0)) * time.Second
+}
+
+// describeCacheKey returns the connection cache key of an object's describe.
+// The key includes a fingerprint of the credentials, as the fields and
+// objects visible depend on the user, so changing them invalidates the cache.
+func describeCacheKey(config salesforceConfig, objectName string) string {
+	hash := sha256.New()
+	for _, value := range []*string{config.URL, config.Username, config.ClientId, config.AccessToken} {
+		if value != nil {
+			hash.Write([]byte(*value))
+		}
+		hash.Write([]byte{0})
+	}
+	return fmt.Sprintf("describe/%x/%s", hash.Sum(nil)[:8], objectName)
+}
+
+// describeSObject returns the describe metadata of a Salesforce object, or nil
+// if it can't be described. Results are kept in the connection cache for
+// describe_cache_ttl_seconds, so the describes made while defining tables are
+// reused by later table rebuilds and queries.
+func describeSObject(ctx context.Context, cc *connection.ConnectionCache, client *simpleforce.Client, config salesforceConfig, objectName string) *simpleforce.SObjectMeta {
+	cacheKey := describeCacheKey(config, objectName)
+	ttl := describeCacheTTL(config)
+	if cc != nil && ttl > 0 {
+		if cachedData, ok := cc.Get(ctx, cacheKey); ok {
+			if sObjectMeta, ok := cachedData.(*simpleforce.SObjectMeta); ok && sObjectMeta != nil {
+				return sObjectMeta
+			}
+			plugin.Logger(ctx).Warn("describeSObject", "msg", "ignoring unexpected connection cache entry", "object_name", objectName, "type", fmt.Sprintf("%T", cachedData))
+		}
+	}
+
+	sObjectMeta := client.SObject(objectName).Describe()
+	if sObjectMeta != nil {
+		if row, err := buildSObjectRow(*sObjectMeta); err == nil && row.ReadOnly {
+			plugin.Logger(ctx).Info("describeSObject", "msg", "object is read-only, its records can't be created, updated or deleted", "object_name", objectName)
+		}
+	}
+	if sObjectMeta != nil && cc != nil && ttl > 0 {
+		if err := cc.SetWithTTL(ctx, cacheKey, sObjectMeta, ttl); err != nil {
+			plugin.Logger(ctx).Error("describeSObject", "cache-set", err)
+		}
+	}
+	return sObjectMeta
+}
+
+// isFieldIncluded returns false if the object_fields block of objectName
+// leaves the field out of its columns, by not including or by excluding it.
+// Id is always included, as records are read and got by it.
+func isFieldIncluded(config salesforceConfig, objectName string, fieldName string) bool {
+	if fieldName == "Id" {
+		return true
+	}
+	matches := func(name string) bool { return strings.EqualFold(name, fieldName) }
+	for _, fields := range config.ObjectFields {
+		if !strings.EqualFold(fields.Object, objectName) {
+			continue
+		}
+		if fields.Include != nil && !slices.ContainsFunc(*fields.Include, matches) {
+			return false
+		}
+		if fields.Exclude != nil && slices.ContainsFunc(*fields.Exclude, matches) {
+			return false
+		}
+		break
+	}
+	return true
+}
+
+// includedFields returns the fields of objectName that isFieldIncluded keeps.
+func includedFields(config salesforceConfig, objectName string, fields []describeField) []describeField {
+	return slices.DeleteFunc(fields, func(field describeField) bool {
+		return !isFieldIncluded(config, objectName, field.Name)
+	})
+}
+
+// dynamicColumns:: Returns list coulms for a salesforce object, and the
+// lower-cased names of the fields the user can read, whether or not they
+// become columns, or nil if the object couldn't be described
+func dynamicColumns(ctx context.Context, cc *connection.ConnectionCache, client *simpleforce.Client, salesforceTableName string, config salesforceConfig) ([]*plugin.Column, plugin.KeyColumnSlice, map[string]string, map[string]bool) {
+	sObjectMeta := describeSObject(ctx, cc, client, config, salesforceTableName)
 	if sObjectMeta == nil {
 		plugin.Logger(ctx).Error("salesforce.dynamicColumns", fmt.Sprintf("Table %s not present in salesforce", salesforceTableName))
-		return []*plugin.Column{}, plugin.KeyColumnSlice{}, map[string]string{}
+		return []*plugin.Column{}, plugin.KeyColumnSlice{}, map[string]string{}, nil
 	}
 
 	// Top columns
@@ -356,34 +1168,37 @@ func dynamicColumns(ctx context.Context, client *simpleforce.Client, salesforceT
 	salesforceCols := map[string]string{}
 	// Key columns
 	keyColumns := plugin.KeyColumnSlice{}
+	// Column names already generated, to skip fields that collide with them
+	fieldsByColumn := map[string]string{"organization_id": ""}
 
-	salesforceObjectMetadata := *sObjectMeta
-	salesforceObjectMetadataAsByte, err := json.Marshal(salesforceObjectMetadata["fields"])
+	fields, err := describeFields(sObjectMeta)
 	if err != nil {
-		plugin.Logger(ctx).Error("salesforce.dynamicColumns", "json marshal error %v", err)
+		plugin.Logger(ctx).Error("salesforce.dynamicColumns", "describe decoding error", err)
 	}
-
-	salesforceObjectFields := []map[string]interface{}{}
-	// var queryColumns []string
-	err = json.Unmarshal(salesforceObjectMetadataAsByte, &salesforceObjectFields)
-	if err != nil {
-		plugin.Logger(ctx).Error("salesforce.dynamicColumns", "json unmarshal error %v", err)
+	fields = accessibleFields(ctx, salesforceTableName, fields)
+	var readableFields map[string]bool
+	if err == nil {
+		readableFields = map[string]bool{}
+		for _, field := range fields {
+			readableFields[strings.ToLower(field.Name)] = true
+		}
 	}
-	for _, fields := range salesforceObjectFields {
-		if fields["name"] == nil {
+	currencyCodeColumn := currencyColumn(config, salesforceTableName, fields)
+	for _, field := range fields {
+		if field.Name == "" || field.SoapType == "" {
 			continue
 		}
-		fieldName := fields["name"].(string)
-		compoundFieldName := fields["compoundFieldName"]
-		if compoundFieldName != nil && compoundFieldName.(string) != fieldName {
+		fieldName := field.Name
+		if !isFieldIncluded(config, salesforceTableName, fieldName) {
 			continue
 		}
-
-		if fields["soapType"] == nil {
+		if field.isCompoundComponent() && !exposeCompoundFields(config) {
+			continue
+		}
+		fieldType := field.soapType()
+		if field.isBlob() && skipBlobFields(config) {
 			continue
 		}
-		soapType := strings.Split((fields["soapType"]).(string), ":")
-		fieldType := soapType[len(soapType)-1]
 
 		// Column dynamic generation
 		// Don't convert to snake case since field names can have underscores in
@@ -393,45 +1208,380 @@ func dynamicColumns(ctx context.Context, client *simpleforce.Client, salesforceT
 		// check if DynamicTableAndPropertyNames is true
 		var columnFieldName string
 
-		// keep the field name as it is if NamingConvention is set to api_native
-		if config.NamingConvention != nil && *config.NamingConvention == "api_native" {
-			columnFieldName = fieldName
-		} else if strings.HasSuffix(fieldName, "__c") {
-			columnFieldName = strings.ToLower(fieldName)
-		} else {
-			columnFieldName = strcase.ToSnake(fieldName)
-		}
+		// keep the field name as it is if NamingConvention is set to api_native
+		if config.NamingConvention != nil && *config.NamingConvention == "api_native" {
+			columnFieldName = fieldName
+		} else if isCustomFieldName(fieldName) {
+			columnFieldName = strings.ToLower(fieldName)
+		} else {
+			columnFieldName = strcase.ToSnake(fieldName)
+		}
+
+		if isBlankColumnName(columnFieldName) {
+			plugin.Logger(ctx).Warn("salesforce.dynamicColumns", "msg", "skipping field with blank column name", "table", salesforceTableName, "field_name", fieldName)
+			continue
+		}
+
+		// Different fields (e.g. "TestField" and "Test_Field") can map to the
+		// same column name, so keep the first one
+		if firstField, ok := fieldsByColumn[columnFieldName]; ok {
+			plugin.Logger(ctx).Warn("salesforce.dynamicColumns", "msg", "skipping field with duplicate column name", "table", salesforceTableName, "field_name", fieldName, "column_name", columnFieldName, "first_field_name", firstField)
+			continue
+		}
+		fieldsByColumn[columnFieldName] = fieldName
+
+		// The content of base64 fields is read from their own resource
+		if field.isBlob() {
+			cols = append(cols, blobColumn(salesforceTableName, fieldName, columnFieldName, field.Label))
+			continue
+		}
+
+		column := plugin.Column{
+			Name:        columnFieldName,
+			Description: field.description(currencyCodeColumn),
+			Transform:   transform.FromP(getFieldFromSObjectMap, fieldName),
+		}
+		salesforceCols[columnFieldName] = fieldType
+
+		// Set column type based on the `soapType` from salesforce schema
+		var operators []string
+		column.Type, operators = columnTypeFromSoapType(ctx, fieldName, fieldType)
+		operators = field.pushdownOperators(operators)
+		if len(operators) > 0 {
+			keyColumns = append(keyColumns, &plugin.KeyColumn{Name: columnFieldName, Require: plugin.Optional, Operators: operators})
+		}
+		// Let Steampipe push ORDER BY down for fields SOQL can sort on
+		if field.Sortable && column.Type != proto.ColumnType_JSON {
+			column.Sort = plugin.SortAll
+		}
+		cols = append(cols, &column)
+	}
+	cols = append(cols, relationshipColumns(ctx, cc, client, sObjectMeta, config, fieldsByColumn)...)
+	return cols, keyColumns, salesforceCols, readableFields
+}
+
+// maxRelationshipDepth caps relationship_depth. SOQL allows five levels, but
+// the number of columns multiplies with each level, as does the number of
+// describes made to define them.
+const maxRelationshipDepth = 2
+
+// relationshipPath is the dotted SOQL path of the parent field a relationship
+// column reads, e.g. Account.Owner.Name. It is the transform parameter of
+// relationship columns, which lets generateQuery select the path.
+type relationshipPath string
+
+// describeField holds the parts of a field describe used to generate
+// columns, relationship columns, picklist values and field metadata.
+// Ref: https://developer.salesforce.com/docs/atlas.en-us.api.meta/api/sforce_api_calls_describesobjects_describesobjectresult.htm#field
+type describeField struct {
+	Name              string   `json:"name"`
+	Label             string   `json:"label"`
+	Type              string   `json:"type"`
+	SoapType          string   `json:"soapType"`
+	Length            int      `json:"length"`
+	Precision         int      `json:"precision"`
+	Scale             int      `json:"scale"`
+	Nillable          bool     `json:"nillable"`
+	Custom            bool     `json:"custom"`
+	Sortable          bool     `json:"sortable"`
+	CompoundFieldName string   `json:"compoundFieldName"`
+	ReferenceTo       []string `json:"referenceTo"`
+	RelationshipName  string   `json:"relationshipName"`
+	Groupable         bool     `json:"groupable"`
+	Aggregatable      bool     `json:"aggregatable"`
+	Accessible        *bool    `json:"accessible"`
+	Filterable        *bool    `json:"filterable"`
+	PicklistValues    []struct {
+		Value        string `json:"value"`
+		Label        string `json:"label"`
+		Active       bool   `json:"active"`
+		DefaultValue bool   `json:"defaultValue"`
+	} `json:"picklistValues"`
+}
+
+// soapType returns the field's soapType without its XML namespace prefix,
+// e.g. "string" for "xsd:string".
+func (f describeField) soapType() string {
+	parts := strings.Split(f.SoapType, ":")
+	return parts[len(parts)-1]
+}
+
+// isAccessible returns false if the describe reports that the user has no
+// field-level read access to the field, in which case selecting it fails the
+// whole query. Describes that don't report it only return readable fields.
+func (f describeField) isAccessible() bool {
+	return f.Accessible == nil || *f.Accessible
+}
+
+// accessibleFields returns the fields of objectName the user can read, logging
+// the others.
+func accessibleFields(ctx context.Context, objectName string, fields []describeField) []describeField {
+	dropped := []string{}
+	fields = slices.DeleteFunc(fields, func(field describeField) bool {
+		if field.isAccessible() {
+			return false
+		}
+		dropped = append(dropped, field.Name)
+		return true
+	})
+	if len(dropped) > 0 {
+		plugin.Logger(ctx).Debug("salesforce.accessibleFields", "msg", "skipping fields without field-level read access", "object_name", objectName, "field_names", strings.Join(dropped, ","))
+	}
+	return fields
+}
+
+// pushdownOperators returns the operators, from those of the field's soapType,
+// that can be sent to Salesforce for the field. Fields that can't be filtered
+// on, such as long text areas, get none, and multi-select picklists don't get
+// LIKE, since SOQL only matches their values with INCLUDES and EXCLUDES.
+// Describes that don't report filterable are assumed filterable.
+func (f describeField) pushdownOperators(operators []string) []string {
+	if f.Filterable != nil && !*f.Filterable {
+		return nil
+	}
+	if f.Type == "multipicklist" {
+		return slices.DeleteFunc(slices.Clone(operators), func(operator string) bool {
+			return operator == "~~" || operator == "~~*"
+		})
+	}
+	return operators
+}
+
+// isCompoundComponent returns true if the field is a component of a compound
+// address or geolocation field, e.g. BillingCity of BillingAddress.
+func (f describeField) isCompoundComponent() bool {
+	return f.CompoundFieldName != "" && f.CompoundFieldName != f.Name
+}
+
+// exposeCompoundFields returns true if expose_compound_fields is set, i.e. the
+// components of compound fields get columns alongside the compound field.
+func exposeCompoundFields(config salesforceConfig) bool {
+	return config.ExposeCompoundFields != nil && *config.ExposeCompoundFields
+}
+
+// isBlob returns true for base64 fields, such as Attachment.Body, whose
+// soapType is base64Binary.
+func (f describeField) isBlob() bool {
+	return f.Type == "base64" || strings.HasPrefix(f.soapType(), "base64")
+}
+
+// description returns the description of the field's column. Currency and
+// percent fields have a double soapType like any number, so their type, digits
+// and decimal places are added to their label. currencyColumn is the column of
+// the record's currency in multi-currency orgs, or empty.
+func (f describeField) description(currencyColumn string) string {
+	switch f.Type {
+	case "currency":
+		description := fmt.Sprintf("%s. Currency amount with up to %d digits, %d of them after the decimal point", f.Label, f.Precision, f.Scale)
+		if currencyColumn != "" {
+			description += ", in the currency of " + currencyColumn
+		}
+		return description + "."
+	case "percent":
+		return fmt.Sprintf("%s. Percentage with up to %d digits, %d of them after the decimal point, e.g. 12.5 for 12.5%%.", f.Label, f.Precision, f.Scale)
+	}
+	return fmt.Sprintf("%s.", f.Label)
+}
+
+// currencyColumn returns the column of the CurrencyIsoCode field, which only
+// multi-currency orgs have, or an empty string if fields don't include it.
+func currencyColumn(config salesforceConfig, objectName string, fields []describeField) string {
+	for _, field := range fields {
+		if field.Name == "CurrencyIsoCode" && isFieldIncluded(config, objectName, field.Name) {
+			return columnNameForField(config, field.Name)
+		}
+	}
+	return ""
+}
+
+// getRelationshipDepth returns the configured relationship_depth, capped at
+// maxRelationshipDepth. Defaults to 0, i.e. no relationship columns.
+func getRelationshipDepth(config salesforceConfig) int {
+	if config.RelationshipDepth == nil {
+		return 0
+	}
+	return min(max(*config.RelationshipDepth, 0), maxRelationshipDepth)
+}
+
+// relationshipColumns returns a column for each field of the parents of the
+// described object, following lookup and master-detail relationships up to
+// relationship_depth levels. Columns are named by joining the relationship
+// and field names with "__", e.g. account__name for Account.Name, or with "."
+// if the naming convention is api_native. Polymorphic relationships, such as
+// Task.Who, are skipped, as SOQL only allows a few fields on them. Column names
+// are added to usedColumns, and names already in it are skipped.
+func relationshipColumns(ctx context.Context, cc *connection.ConnectionCache, client *simpleforce.Client, sObjectMeta *simpleforce.SObjectMeta, config salesforceConfig, usedColumns map[string]string) []*plugin.Column {
+	depth := getRelationshipDepth(config)
+	if depth == 0 {
+		return nil
+	}
+	separator := "__"
+	if config.NamingConvention != nil && *config.NamingConvention == "api_native" {
+		separator = "."
+	}
+
+	cols := []*plugin.Column{}
+	var walk func(fields []describeField, pathPrefix string, columnPrefix string, level int)
+	walk = func(fields []describeField, pathPrefix string, columnPrefix string, level int) {
+		for _, field := range fields {
+			if len(field.ReferenceTo) != 1 || field.RelationshipName == "" || !field.isAccessible() {
+				continue
+			}
+			parentFields, err := describeFields(describeSObject(ctx, cc, client, config, field.ReferenceTo[0]))
+			if err != nil {
+				plugin.Logger(ctx).Warn("salesforce.relationshipColumns", "msg", "skipping relationship of an object that can't be described", "relationship_name", field.RelationshipName, "object_name", field.ReferenceTo[0], "error", err)
+				continue
+			}
+			parentFields = accessibleFields(ctx, field.ReferenceTo[0], includedFields(config, field.ReferenceTo[0], parentFields))
+			path := pathPrefix + field.RelationshipName
+			relationshipColumn := columnPrefix + columnNameForField(config, field.RelationshipName)
+
+			for _, parentField := range parentFields {
+				if parentField.SoapType == "" || parentField.isCompoundComponent() {
+					continue
+				}
+				if isBlankColumnName(columnNameForField(config, parentField.Name)) {
+					continue
+				}
+				columnName := relationshipColumn + separator + columnNameForField(config, parentField.Name)
+				if _, ok := usedColumns[columnName]; ok {
+					continue
+				}
+				fieldPath := path + "." + parentField.Name
+				usedColumns[columnName] = fieldPath
+
+				columnType, _ := columnTypeFromSoapType(ctx, fieldPath, parentField.soapType())
+				column := &plugin.Column{
+					Name:        columnName,
+					Type:        columnType,
+					Description: fmt.Sprintf("%s (%s).", parentField.Label, fieldPath),
+					Transform:   transform.FromP(getFieldFromSObjectPath, relationshipPath(fieldPath)),
+				}
+				// SOQL sorts on parent fields without selecting them, so the
+				// ORDER BY is pushed down even if the column isn't requested
+				if parentField.Sortable && columnType != proto.ColumnType_JSON {
+					column.Sort = plugin.SortAll
+				}
+				cols = append(cols, column)
+			}
+
+			if level < depth {
+				walk(parentFields, path+".", relationshipColumn+separator, level+1)
+			}
+		}
+	}
+
+	fields, err := describeFields(sObjectMeta)
+	if err != nil {
+		plugin.Logger(ctx).Error("salesforce.relationshipColumns", "describe decoding error", err)
+		return nil
+	}
+	// Relationships of lookup fields left out by object_fields are skipped, and
+	// so are parent fields left out by their object's object_fields
+	objectName, _ := (*sObjectMeta)["name"].(string)
+	walk(includedFields(config, objectName, fields), "", "", 1)
+	return cols
+}
+
+// describeFields decodes the fields of an object describe.
+func describeFields(sObjectMeta *simpleforce.SObjectMeta) ([]describeField, error) {
+	if sObjectMeta == nil {
+		return nil, fmt.Errorf("object not described")
+	}
+	data, err := json.Marshal((*sObjectMeta)["fields"])
+	if err != nil {
+		return nil, err
+	}
+	fields := []describeField{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// columnNameForField returns the column name of a field or relationship name
+// under the configured naming convention.
+func columnNameForField(config salesforceConfig, fieldName string) string {
+	if config.NamingConvention != nil && *config.NamingConvention == "api_native" {
+		return fieldName
+	}
+	if isCustomFieldName(fieldName) || strings.HasSuffix(fieldName, "__r") {
+		return strings.ToLower(fieldName)
+	}
+	return strcase.ToSnake(fieldName)
+}
+
+// isBlankColumnName returns true for the column names of fields whose names
+// are empty or made only of spaces and underscores, which would make blank or
+// meaningless columns.
+func isBlankColumnName(name string) bool {
+	return strings.Trim(name, " _") == ""
+}
+
+// isRelationshipColumn returns true for the columns generated by
+// relationshipColumns, returning the path of the parent field they read.
+func isRelationshipColumn(column *plugin.Column) (relationshipPath, bool) {
+	if column.Transform == nil || len(column.Transform.Transforms) == 0 {
+		return "", false
+	}
+	path, ok := column.Transform.Transforms[0].Param.(relationshipPath)
+	return path, ok
+}
 
-		column := plugin.Column{
-			Name:        columnFieldName,
-			Description: fmt.Sprintf("%s.", fields["label"].(string)),
-			Transform:   transform.FromP(getFieldFromSObjectMap, fieldName),
-		}
-		salesforceCols[columnFieldName] = fieldType
+// salesforceFieldName returns the SOQL field a column reads: the parent field
+// path of a relationship column, or the field named after the column.
+func salesforceFieldName(column *plugin.Column) string {
+	if path, ok := isRelationshipColumn(column); ok {
+		return string(path)
+	}
+	return getSalesforceColumnName(column.Name)
+}
 
-		// Set column type based on the `soapType` from salesforce schema
-		switch fieldType {
-		case "string", "ID", "time":
-			column.Type = proto.ColumnType_STRING
-			keyColumns = append(keyColumns, &plugin.KeyColumn{Name: columnFieldName, Require: plugin.Optional, Operators: []string{"=", "<>"}})
-		case "date", "dateTime":
-			column.Type = proto.ColumnType_TIMESTAMP
-			keyColumns = append(keyColumns, &plugin.KeyColumn{Name: columnFieldName, Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}})
-		case "boolean":
-			column.Type = proto.ColumnType_BOOL
-			keyColumns = append(keyColumns, &plugin.KeyColumn{Name: columnFieldName, Require: plugin.Optional, Operators: []string{"=", "<>"}})
-		case "double":
-			column.Type = proto.ColumnType_DOUBLE
-			keyColumns = append(keyColumns, &plugin.KeyColumn{Name: columnFieldName, Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}})
-		case "int":
-			column.Type = proto.ColumnType_INT
-			keyColumns = append(keyColumns, &plugin.KeyColumn{Name: columnFieldName, Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}})
-		default:
-			column.Type = proto.ColumnType_JSON
+// queryColumns returns the columns to select in SOQL: all of them, except the
+// relationship columns that aren't requested, since each relationship
+// traversed makes the query more expensive.
+func queryColumns(columns []*plugin.Column, requestedColumns []string) []*plugin.Column {
+	selected := []*plugin.Column{}
+	for _, column := range columns {
+		if _, ok := isRelationshipColumn(column); ok && !slices.Contains(requestedColumns, column.Name) {
+			continue
 		}
-		cols = append(cols, &column)
+		selected = append(selected, column)
+	}
+	return selected
+}
+
+// columnTypeFromSoapType maps a Salesforce field `soapType` to a Steampipe
+// column type and the qual operators that can be pushed down for it. Fields
+// with an unrecognized soapType become JSON columns without pushdown.
+func columnTypeFromSoapType(ctx context.Context, fieldName string, fieldType string) (proto.ColumnType, []string) {
+	switch fieldType {
+	case "string":
+		return proto.ColumnType_STRING, []string{"=", "<>", "~~", "~~*", "is null", "is not null"}
+	case "ID":
+		// SOQL only supports LIKE on text fields. IDs are compared case-sensitively,
+		// in the same order as Postgres compares them with the C collation, so ID
+		// ranges can be pushed down, e.g. for PK chunking. Text comparisons are
+		// case-insensitive in SOQL and aren't.
+		return proto.ColumnType_STRING, []string{"=", "<>", ">", ">=", "<=", "<", "is null", "is not null"}
+	case "time":
+		return proto.ColumnType_STRING, []string{"=", "<>", "is null", "is not null"}
+	case "date", "dateTime":
+		return proto.ColumnType_TIMESTAMP, []string{"=", ">", ">=", "<=", "<", "is null", "is not null"}
+	case "boolean":
+		// Checkbox fields are never null
+		return proto.ColumnType_BOOL, []string{"=", "<>"}
+	case "double":
+		return proto.ColumnType_DOUBLE, []string{"=", "<>", ">", ">=", "<=", "<", "is null", "is not null"}
+	case "int":
+		return proto.ColumnType_INT, []string{"=", "<>", ">", ">=", "<=", "<", "is null", "is not null"}
+	case "address", "location", "anyType", "base64":
+		return proto.ColumnType_JSON, nil
+	default:
+		// Let maintainers know about types that may deserve dedicated handling
+		plugin.Logger(ctx).Debug("salesforce.columnTypeFromSoapType", "unrecognized_soap_type", fieldType, "field_name", fieldName)
+		return proto.ColumnType_JSON, nil
 	}
-	return cols, keyColumns, salesforceCols
 }
 
 var getOrganizationIdMemoize = plugin.HydrateFunc(getOrganizationIdUncached).Memoize(memoize.WithCacheKeyFunction(getOrganizationIdCacheKey))
@@ -466,7 +1616,7 @@ func getOrganizationIdUncached(ctx context.Context, d *plugin.QueryData, h *plug
 	// SOQL Query to retrieve organization details
 	query := "SELECT Id, Name, InstanceName, IsSandbox FROM Organization"
 
-	_, result, err := queryWithRetry(ctx, d, client, query)
+	_, result, err := queryWithRetry(ctx, d, client, "Organization", query)
 	if err != nil {
 		plugin.Logger(ctx).Error("salesforce.getOrganizationIdUncached", "api error", err)
 		return nil, err
@@ -476,10 +1626,28 @@ func getOrganizationIdUncached(ctx context.Context, d *plugin.QueryData, h *plug
 		orgId = result.Records[0].ID()
 	}
 
-
 	return orgId, nil
 }
 
+// configuredObjectNames returns the static table objects followed by any
+// additional objects from the connection config, without duplicates.
+func configuredObjectNames(config salesforceConfig) []string {
+	names := []string{}
+	seen := map[string]bool{}
+	candidates := append([]string{}, staticTables...)
+	if config.Objects != nil {
+		candidates = append(candidates, *config.Objects...)
+	}
+	for _, name := range candidates {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names
+}
+
 // isColumnAvailable:: Checks if the column is not present in the existing columns slice
 func isColumnAvailable(columnName string, columns []*plugin.Column) bool {
 	for _, col := range columns {
@@ -490,7 +1658,20 @@ func isColumnAvailable(columnName string, columns []*plugin.Column) bool {
 	return false
 }
 
-var sandboxPattern = regexp.MustCompile(`(?i)(sandbox|[/.]cs\d+\.|test\.salesforce\.com)`)
+// sandboxPattern matches the URLs of orgs that log in through
+// test.salesforce.com:
+//   - enhanced domains of sandboxes and scratch orgs, e.g.
+//     acme--uat.sandbox.my.salesforce.com or
+//     speed-ruby-1234-dev-ed.scratch.my.salesforce.com
+//   - My Domains of sandboxes before enhanced domains, e.g.
+//     acme--uat.my.salesforce.com or acme--uat.cs42.my.salesforce.com
+//   - sandbox instances, e.g. cs42.salesforce.com, and test.salesforce.com
+//
+// Developer Edition (.develop.), demo (.demo.) and Trailhead Playground
+// (.trailblaze.) orgs are production orgs, which log in through
+// login.salesforce.com. Domain segments are matched, so a production My Domain
+// such as mysandbox.my.salesforce.com isn't mistaken for a sandbox.
+var sandboxPattern = regexp.MustCompile(`(?i)(\.(sandbox|scratch)\.|--[a-z0-9]+\.|[/.]cs\d+\.|(^|[/.])test\.salesforce\.com)`)
 
 // loginURL determines the Salesforce login endpoint based on the instance URL.
 // Sandbox instances use test.salesforce.com, production uses login.salesforce.com.
@@ -501,22 +1682,150 @@ func loginURL(instanceURL string) string {
 	return "https://login.salesforce.com"
 }
 
+// getLoginURL returns the base of the OAuth endpoints used by the refresh
+// token and JWT flows: the configured login_url, e.g. a My Domain host, or
+// the endpoint loginURL picks for url. A trailing slash is removed.
+func getLoginURL(config salesforceConfig) string {
+	if config.LoginURL != nil && strings.TrimSpace(*config.LoginURL) != "" {
+		return strings.TrimRight(strings.TrimSpace(*config.LoginURL), "/")
+	}
+	return loginURL(*config.URL)
+}
+
+// pemBlockPattern matches a PEM block whose newlines may have been lost, with
+// the block type of its header and footer and its body.
+var pemBlockPattern = regexp.MustCompile(`(?s)^-----BEGIN ([A-Z0-9 ]+)-----(.*?)-----END ([A-Z0-9 ]+)-----$`)
+
 // loadPrivateKey returns the PEM string from either inline config or file.
 // Inline takes precedence over file.
 func loadPrivateKey(privateKey *string, privateKeyFile *string) (string, error) {
 	if privateKey != nil && *privateKey != "" {
-		return *privateKey, nil
+		return normalizePrivateKeyPEM(*privateKey), nil
 	}
 	if privateKeyFile != nil && *privateKeyFile != "" {
-		data, err := os.ReadFile(*privateKeyFile)
+		path, err := expandHomeDir(*privateKeyFile)
 		if err != nil {
 			return "", fmt.Errorf("failed to read private key file %q: %v", *privateKeyFile, err)
 		}
-		return string(data), nil
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read private key file %q: %v", *privateKeyFile, err)
+		}
+		return normalizePrivateKeyPEM(string(data)), nil
 	}
 	return "", fmt.Errorf("either private_key or private_key_file must be set")
 }
 
+// normalizePrivateKeyPEM returns a PEM private key delivered in one of the
+// forms secret stores and environment variables use instead of PEM:
+//   - PEM with its newlines escaped as "\n", or replaced by spaces
+//   - base64-encoded PEM
+//   - base64-encoded DER, which is wrapped in a "PRIVATE KEY" block
+//
+// Valid PEM, and keys in any other form, are returned unchanged, leaving the
+// error reporting to the key parser.
+func normalizePrivateKeyPEM(key string) string {
+	if block, _ := pem.Decode([]byte(key)); block != nil {
+		return key
+	}
+
+	trimmed := strings.TrimSpace(strings.ReplaceAll(key, `\n`, "\n"))
+	if strings.HasPrefix(trimmed, "-----BEGIN ") {
+		return rewrapPEM(trimmed, key)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(trimmed), ""))
+	if err != nil || len(decoded) == 0 {
+		return key
+	}
+	if block, _ := pem.Decode(decoded); block != nil {
+		return string(decoded)
+	}
+	if decodedKey := strings.TrimSpace(string(decoded)); strings.HasPrefix(decodedKey, "-----BEGIN ") {
+		return rewrapPEM(decodedKey, key)
+	}
+	// A DER private key is an ASN.1 SEQUENCE
+	if decoded[0] == 0x30 {
+		return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: decoded}))
+	}
+	return key
+}
+
+// rewrapPEM returns the PEM text key, whose newlines may have been lost, with
+// the base64 body of its block re-encoded in lines of 64 characters. The
+// original key is returned if key isn't a single PEM block, or if it has
+// headers, such as those of legacy encrypted keys, which can't be told apart
+// from the body once the newlines are gone.
+func rewrapPEM(key string, original string) string {
+	if block, _ := pem.Decode([]byte(key)); block != nil {
+		return key
+	}
+
+	match := pemBlockPattern.FindStringSubmatch(key)
+	if match == nil || match[1] != match[3] || strings.Contains(match[2], ":") {
+		return original
+	}
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(match[2]), ""))
+	if err != nil {
+		return original
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: match[1], Bytes: der}))
+}
+
+// expandHomeDir replaces a leading "~" or "~/" in path with the user's home
+// directory, as a shell would. Other paths, including "~user/..." forms, are
+// returned unchanged.
+func expandHomeDir(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, path[1:]), nil
+}
+
+// decryptPrivateKey returns privateKeyPEM with its key block decrypted using
+// passphrase. Both legacy encrypted PEM ("Proc-Type: 4,ENCRYPTED") and PKCS8
+// "ENCRYPTED PRIVATE KEY" blocks are supported; unencrypted keys are returned
+// unchanged.
+func decryptPrivateKey(privateKeyPEM, passphrase string) (string, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		// Leave the error reporting to the key parser
+		return privateKeyPEM, nil
+	}
+
+	// x509.IsEncryptedPEMBlock is deprecated because legacy PEM encryption is
+	// insecure, but it is still what `openssl rsa -des3` style keys use
+	legacy := x509.IsEncryptedPEMBlock(block) //nolint:staticcheck
+	if !legacy && block.Type != "ENCRYPTED PRIVATE KEY" {
+		return privateKeyPEM, nil
+	}
+	if passphrase == "" {
+		return "", fmt.Errorf("private key is encrypted; set 'private_key_passphrase' to decrypt it")
+	}
+
+	if legacy {
+		der, err := x509.DecryptPEMBlock(block, []byte(passphrase)) //nolint:staticcheck
+		if err != nil {
+			return "", fmt.Errorf("failed to decrypt private key: %v", err)
+		}
+		return string(pem.EncodeToMemory(&pem.Block{Type: block.Type, Bytes: der})), nil
+	}
+
+	key, err := pkcs8.ParsePKCS8PrivateKey(block.Bytes, []byte(passphrase))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt private key: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", fmt.Errorf("failed to re-encode decrypted private key: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
 // salesforceJWTClaims is a custom JWT claims type that serializes the "aud" claim
 // as a string instead of an array. Salesforce requires the audience to be a string.
 type salesforceJWTClaims struct {
@@ -550,38 +1859,73 @@ func (c salesforceJWTClaims) GetAudience() (jwt.ClaimStrings, error) {
 	return jwt.ClaimStrings{c.Audience}, nil
 }
 
-// loginJWT performs the OAuth 2.0 JWT Bearer flow.
-// loginURL is the Salesforce token endpoint base (e.g. "https://login.salesforce.com").
-// Returns the access_token and instance_url from the token response.
-func loginJWT(loginEndpoint, clientID, username, privateKeyPEM string) (string, string, error) {
-	// Parse the RSA private key
+// parseJWTSigningKey parses an RSA or ECDSA private key from PEM and returns
+// it with the matching JWT signing method: RS256 for RSA, and ES256, ES384 or
+// ES512 for ECDSA keys depending on the curve.
+func parseJWTSigningKey(privateKeyPEM string) (interface{}, jwt.SigningMethod, error) {
 	block, _ := pem.Decode([]byte(privateKeyPEM))
 	if block == nil {
-		return "", "", fmt.Errorf("failed to decode PEM block from private key")
+		return nil, nil, fmt.Errorf("failed to decode PEM block from private key")
 	}
-	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
-	if err != nil {
-		// Try PKCS8 as fallback
-		keyIface, err2 := x509.ParsePKCS8PrivateKey(block.Bytes)
-		if err2 != nil {
-			return "", "", fmt.Errorf("failed to parse private key: %v (PKCS1: %v)", err2, err)
+
+	var key interface{}
+	rsaKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err == nil {
+		key = rsaKey
+	} else {
+		// Try PKCS8, then SEC 1 ("EC PRIVATE KEY") as fallbacks
+		pkcs8Key, err2 := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err2 == nil {
+			key = pkcs8Key
+		} else {
+			ecKey, err3 := x509.ParseECPrivateKey(block.Bytes)
+			if err3 != nil {
+				return nil, nil, fmt.Errorf("failed to parse private key: %v (PKCS1: %v, EC: %v)", err2, err, err3)
+			}
+			key = ecKey
 		}
-		var ok bool
-		key, ok = keyIface.(*rsa.PrivateKey)
-		if !ok {
-			return "", "", fmt.Errorf("PKCS8 key is not RSA")
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, jwt.SigningMethodRS256, nil
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return k, jwt.SigningMethodES256, nil
+		case elliptic.P384():
+			return k, jwt.SigningMethodES384, nil
+		case elliptic.P521():
+			return k, jwt.SigningMethodES512, nil
 		}
+		return nil, nil, fmt.Errorf("unsupported EC curve %s; use P-256, P-384 or P-521", k.Curve.Params().Name)
+	}
+	return nil, nil, fmt.Errorf("private key is neither RSA nor ECDSA (%T)", key)
+}
+
+// loginJWT performs the OAuth 2.0 JWT Bearer flow.
+// loginURL is the Salesforce token endpoint base (e.g. "https://login.salesforce.com").
+// audience is the "aud" claim of the JWT, e.g. the URL of an Experience Cloud
+// site; the login endpoint is used if it is empty.
+// Returns the access_token and instance_url from the token response.
+func loginJWT(httpClient *http.Client, loginEndpoint, audience, clientID, username, privateKeyPEM string) (string, string, error) {
+	key, signingMethod, err := parseJWTSigningKey(privateKeyPEM)
+	if err != nil {
+		return "", "", err
 	}
 
 	// Build JWT claims with string audience (Salesforce requires aud to be a string, not array)
+	if audience == "" {
+		audience = loginEndpoint
+	}
 	now := time.Now()
 	claims := salesforceJWTClaims{
 		Issuer:    clientID,
 		Subject:   username,
-		Audience:  loginEndpoint,
+		Audience:  audience,
 		ExpiresAt: now.Add(3 * time.Minute).Unix(),
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token := jwt.NewWithClaims(signingMethod, claims)
 	signedJWT, err := token.SignedString(key)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to sign JWT: %v", err)
@@ -594,7 +1938,7 @@ func loginJWT(loginEndpoint, clientID, username, privateKeyPEM string) (string,
 		"assertion":  {signedJWT},
 	}
 
-	resp, err := http.PostForm(tokenURL, form)
+	resp, err := httpClient.PostForm(tokenURL, form)
 	if err != nil {
 		return "", "", fmt.Errorf("token request failed: %v", err)
 	}
@@ -630,7 +1974,7 @@ func loginJWT(loginEndpoint, clientID, username, privateKeyPEM string) (string,
 // refreshAccessToken exchanges a refresh_token for a new access_token.
 // loginEndpoint is the Salesforce token endpoint base (e.g. "https://login.salesforce.com").
 // Returns the access_token and instance_url from the token response.
-func refreshAccessToken(loginEndpoint, clientID, clientSecret, refreshToken string) (string, string, error) {
+func refreshAccessToken(httpClient *http.Client, loginEndpoint, clientID, clientSecret, refreshToken string) (string, string, error) {
 	tokenURL := loginEndpoint + "/services/oauth2/token"
 	form := url.Values{
 		"grant_type":    {"refresh_token"},
@@ -639,7 +1983,7 @@ func refreshAccessToken(loginEndpoint, clientID, clientSecret, refreshToken stri
 		"refresh_token": {refreshToken},
 	}
 
-	resp, err := http.PostForm(tokenURL, form)
+	resp, err := httpClient.PostForm(tokenURL, form)
 	if err != nil {
 		return "", "", fmt.Errorf("refresh request failed: %v", err)
 	}
@@ -672,6 +2016,98 @@ func refreshAccessToken(loginEndpoint, clientID, clientSecret, refreshToken stri
 	return accessToken, instanceURL, nil
 }
 
+// checkAccessTokenURL compares the instance an access_token was issued for
+// with the configured url, as set by access_token_url_mismatch. A token used
+// against another instance fails every query with INVALID_SESSION_ID, which
+// doesn't say why. The instance is read from the userinfo endpoint of the
+// login host, so the check costs a request per connection and is off by
+// default. Failures of the check itself are logged and ignored.
+func checkAccessTokenURL(ctx context.Context, httpClient *http.Client, config salesforceConfig) error {
+	if config.AccessTokenURLMismatch == nil || *config.AccessTokenURLMismatch == ACCESS_TOKEN_URL_MISMATCH_IGNORE {
+		return nil
+	}
+
+	tokenInstanceURL, err := accessTokenInstanceURL(httpClient, getLoginURL(config), normalizeAccessToken(*config.AccessToken))
+	if err != nil {
+		plugin.Logger(ctx).Warn("checkAccessTokenURL", "msg", "unable to read the instance of access_token, skipping check", "error", err)
+		return nil
+	}
+	if !instanceURLMismatch(*config.URL, tokenInstanceURL) {
+		return nil
+	}
+
+	mismatch := fmt.Errorf("access_token was issued for %s, but url is %s", tokenInstanceURL, *config.URL)
+	if *config.AccessTokenURLMismatch == ACCESS_TOKEN_URL_MISMATCH_ERROR {
+		return mismatch
+	}
+	plugin.Logger(ctx).Warn("checkAccessTokenURL", "msg", mismatch.Error())
+	return nil
+}
+
+// accessTokenInstanceURL returns the instance URL, e.g.
+// https://mydomain.my.salesforce.com, of the org an access token was issued
+// for, as read from the REST URL of the OAuth userinfo endpoint.
+// Ref: https://help.salesforce.com/s/articleView?id=sf.remoteaccess_using_userinfo_endpoint.htm
+func accessTokenInstanceURL(httpClient *http.Client, loginEndpoint, accessToken string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, loginEndpoint+"/services/oauth2/userinfo", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("userinfo request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read userinfo response: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("userinfo request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var result struct {
+		URLs struct {
+			Rest string `json:"rest"`
+		} `json:"urls"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("failed to parse userinfo response: %v", err)
+	}
+	restURL, err := url.Parse(result.URLs.Rest)
+	if err != nil || restURL.Host == "" {
+		return "", fmt.Errorf("userinfo response has no REST URL")
+	}
+	return restURL.Scheme + "://" + restURL.Host, nil
+}
+
+// instanceURLMismatch returns true if the two URLs point to different hosts.
+// Schemes, paths, trailing slashes and the case of host names are ignored.
+// URLs that can't be parsed aren't reported as a mismatch.
+func instanceURLMismatch(configuredURL, tokenInstanceURL string) bool {
+	configuredHost, instanceHost := urlHostname(configuredURL), urlHostname(tokenInstanceURL)
+	if configuredHost == "" || instanceHost == "" {
+		return false
+	}
+	return !strings.EqualFold(configuredHost, instanceHost)
+}
+
+// urlHostname returns the host name of rawURL, which may omit the scheme.
+func urlHostname(rawURL string) string {
+	rawURL = strings.TrimSpace(rawURL)
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return parsed.Hostname()
+}
+
 // isSessionExpiredError checks whether an error from simpleforce indicates
 // an expired or invalid Salesforce session.
 // simpleforce errors are plain strings with format:
@@ -693,6 +2129,87 @@ func isAccessTokenAuth(config salesforceConfig) bool {
 	return config.AccessToken != nil && *config.AccessToken != ""
 }
 
+// authMethod returns the authentication method connectRaw uses for config,
+// following the same precedence, or an empty string if no credentials are
+// configured.
+func authMethod(config salesforceConfig) string {
+	switch {
+	case isAccessTokenAuth(config):
+		return "access_token"
+	case config.RefreshToken != nil && *config.RefreshToken != "":
+		return "refresh_token"
+	case (config.PrivateKey != nil && *config.PrivateKey != "") || (config.PrivateKeyFile != nil && *config.PrivateKeyFile != ""):
+		return "jwt"
+	case config.Username != nil && *config.Username != "" && config.Password != nil && *config.Password != "":
+		return "password"
+	}
+	return ""
+}
+
+// getMaxAuthRetries returns how many times a request is re-authenticated and
+// retried after a session expiry. Defaults to 1; 0 disables re-authentication.
+func getMaxAuthRetries(config salesforceConfig) int {
+	if config.MaxAuthRetries == nil {
+		return 1
+	}
+	if *config.MaxAuthRetries < 0 {
+		return 0
+	}
+	return *config.MaxAuthRetries
+}
+
+// retryPolicy controls how often, and how quickly, a request is retried after
+// the Salesforce session expires.
+type retryPolicy struct {
+	MaxRetries int
+	Backoff    time.Duration
+}
+
+// getRetryPolicy returns the retry policy for objectName: the connection-level
+// max_auth_retries and retry_backoff_ms, overridden by the object_retry_policy
+// block for the object if there is one. Object names are matched
+// case-insensitively, as in SOQL. An empty objectName gets the connection-level
+// policy.
+func getRetryPolicy(config salesforceConfig, objectName string) retryPolicy {
+	policy := retryPolicy{MaxRetries: getMaxAuthRetries(config)}
+	if config.RetryBackoffMs != nil && *config.RetryBackoffMs > 0 {
+		policy.Backoff = time.Duration(*config.RetryBackoffMs) * time.Millisecond
+	}
+	if objectName == "" {
+		return policy
+	}
+
+	for _, override := range config.ObjectRetryPolicies {
+		if !strings.EqualFold(override.Object, objectName) {
+			continue
+		}
+		if override.MaxAuthRetries != nil {
+			policy.MaxRetries = max(*override.MaxAuthRetries, 0)
+		}
+		if override.RetryBackoffMs != nil {
+			policy.Backoff = time.Duration(max(*override.RetryBackoffMs, 0)) * time.Millisecond
+		}
+		break
+	}
+	return policy
+}
+
+// wait sleeps before the given retry attempt (starting at 1), doubling the
+// backoff after each attempt. It returns early if ctx is cancelled.
+func (p retryPolicy) wait(ctx context.Context, attempt int) error {
+	if p.Backoff <= 0 {
+		return nil
+	}
+	// Cap the doubling so the delay can't overflow
+	delay := p.Backoff << min(attempt-1, 10)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
 // reconnect clears the cached client and re-authenticates.
 // Returns an error if the current auth method is access_token (cannot refresh).
 func reconnect(ctx context.Context, d *plugin.QueryData) (*simpleforce.Client, error) {
@@ -701,7 +2218,7 @@ func reconnect(ctx context.Context, d *plugin.QueryData) (*simpleforce.Client, e
 		return nil, fmt.Errorf("salesforce session expired; access_token auth cannot be refreshed automatically — obtain a new token and update the config")
 	}
 
-	plugin.Logger(ctx).Info("salesforce.reconnect", "msg", "session expired, re-authenticating")
+	plugin.Logger(ctx).Debug("salesforce.reconnect", "msg", "session expired, re-authenticating")
 
 	// Clear cached client
 	if d.ConnectionCache != nil {
@@ -712,27 +2229,159 @@ func reconnect(ctx context.Context, d *plugin.QueryData) (*simpleforce.Client, e
 	return connect(ctx, d)
 }
 
-// queryWithRetry executes a SOQL query via client.Query(). If the query fails
-// due to session expiration, it reconnects and retries once.
-func queryWithRetry(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, query string) (*simpleforce.Client, *simpleforce.QueryResult, error) {
-	result, err := client.Query(query)
-	if err == nil {
-		return client, result, nil
+// maxQueryURLLength is the longest request URI Salesforce accepts; longer
+// query URLs are rejected with 414 URI Too Long.
+const maxQueryURLLength = 16384
+
+// REST resources SOQL queries are sent to. queryAll also returns records that
+// are deleted, i.e. in the Recycle Bin, or archived.
+const (
+	queryResource    = "query"
+	queryAllResource = "queryAll"
+)
+
+// getQueryResource returns queryAllResource if include_deleted is set, or if
+// the table is filtered on is_deleted being true, which query never returns.
+// Otherwise it returns queryResource.
+func getQueryResource(d *plugin.QueryData) string {
+	config := GetConfig(d.Connection)
+	if config.IncludeDeleted != nil && *config.IncludeDeleted {
+		return queryAllResource
+	}
+	for _, columnName := range []string{"is_deleted", "IsDeleted"} {
+		keyColumnQuals := d.Quals[columnName]
+		if keyColumnQuals == nil {
+			continue
+		}
+		for _, qual := range keyColumnQuals.Quals {
+			if qual.Value == nil {
+				continue
+			}
+			if (qual.Operator == "=" && qual.Value.GetBoolValue()) || (qual.Operator == "<>" && !qual.Value.GetBoolValue()) {
+				return queryAllResource
+			}
+		}
 	}
+	return queryResource
+}
 
-	if !isSessionExpiredError(err) {
-		return client, nil, err
+// queryPath returns the path of a GET of resource for a SOQL query. Unlike the
+// URLs client.Query() builds from a query, the query is escaped as a query
+// parameter, so characters such as & and + in values are kept. Queries are
+// always sent to client.Query() as a path.
+func queryPath(apiVersion string, resource string, query string) string {
+	return fmt.Sprintf("/services/data/v%s/%s?q=%s", apiVersion, resource, strings.ReplaceAll(url.QueryEscape(query), "+", "%20"))
+}
+
+// queryURLLength returns the length of the URL requested for a SOQL query
+// sent to resource. Paging URLs are short and always fit.
+func queryURLLength(instanceURL string, apiVersion string, resource string, query string) int {
+	if strings.HasPrefix(query, "/services/data") {
+		return len(instanceURL) + len(query)
+	}
+	return len(instanceURL) + len(queryPath(apiVersion, resource, query))
+}
+
+// runQuery executes a SOQL query via client.Query(), or, when its URL would
+// exceed maxQueryURLLength, as configured by long_query_mode: sent in the
+// body of a POST request (the default), or failed with an explanatory error.
+// The query is sent to the resource getQueryResource returns.
+func runQuery(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, query string) (*simpleforce.QueryResult, error) {
+	config := GetConfig(d.Connection)
+	apiVersion := getAPIVersion(config)
+	resource := getQueryResource(d)
+	length := queryURLLength(client.GetLoc(), apiVersion, resource, query)
+	if length <= maxQueryURLLength {
+		if !strings.HasPrefix(query, "/services/data") {
+			// client.Query() requests paths under /services/data, such as paging
+			// URLs, as they are
+			return client.Query(queryPath(apiVersion, resource, query))
+		}
+		return client.Query(query)
+	}
+
+	if config.LongQueryMode != nil && *config.LongQueryMode == LONG_QUERY_ERROR {
+		return nil, fmt.Errorf("query URL is %d characters long, over the Salesforce limit of %d; select fewer columns or filter on fewer values, or set long_query_mode to \"post\"", length, maxQueryURLLength)
+	}
+
+	plugin.Logger(ctx).Debug("salesforce.runQuery", "msg", "query URL too long, sending query in a POST body", "url_length", length)
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return queryWithPost(httpClient, client, apiVersion, resource, query)
+}
+
+// queryWithPost sends a SOQL query as a form-encoded POST body, with the
+// X-HTTP-Method-Override header telling Salesforce to handle it as a GET of
+// resource. It avoids the URL length limit of client.Query().
+func queryWithPost(httpClient *http.Client, client *simpleforce.Client, apiVersion string, resource string, query string) (*simpleforce.QueryResult, error) {
+	endpoint := fmt.Sprintf("%s/services/data/v%s/%s", client.GetLoc(), apiVersion, resource)
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(url.Values{"q": {query}}.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+client.GetSid())
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-HTTP-Method-Override", http.MethodGet)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, simpleforce.ParseSalesforceError(resp.StatusCode, body)
+	}
+
+	var result simpleforce.QueryResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse query response: %v", err)
 	}
+	return &result, nil
+}
 
-	plugin.Logger(ctx).Warn("salesforce.queryWithRetry", "msg", "session expired, reconnecting", "error", err)
+// withSessionRetry calls request with client. If the request fails due to
+// session expiration, it reconnects and calls request again with the new
+// client, according to the retry policy of objectName. It returns the client
+// of the last call, which callers should keep using.
+func withSessionRetry(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, objectName string, request func(client *simpleforce.Client) error) (*simpleforce.Client, error) {
+	err := request(client)
+	policy := getRetryPolicy(GetConfig(d.Connection), objectName)
+	for attempt := 1; err != nil && isSessionExpiredError(err) && attempt <= policy.MaxRetries; attempt++ {
+		plugin.Logger(ctx).Debug("salesforce.withSessionRetry", "msg", "session expired, reconnecting", "object_name", objectName, "attempt", attempt, "error", err)
+		if waitErr := policy.wait(ctx, attempt); waitErr != nil {
+			return client, waitErr
+		}
 
-	newClient, reconnErr := reconnect(ctx, d)
-	if reconnErr != nil {
-		return client, nil, reconnErr
+		newClient, reconnErr := reconnect(ctx, d)
+		if reconnErr != nil {
+			return client, reconnErr
+		}
+		client = newClient
+		err = request(client)
 	}
+	return client, err
+}
 
-	result, err = newClient.Query(query)
-	return newClient, result, err
+// queryWithRetry executes a SOQL query via runQuery(). If the query fails
+// due to session expiration, it reconnects and retries according to the retry
+// policy of objectName.
+func queryWithRetry(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, objectName string, query string) (*simpleforce.Client, *simpleforce.QueryResult, error) {
+	var result *simpleforce.QueryResult
+	client, err := withSessionRetry(ctx, d, client, objectName, func(client *simpleforce.Client) (err error) {
+		result, err = runQuery(ctx, d, client, query)
+		return err
+	})
+	if err != nil {
+		return client, nil, queryTimeoutError(err, GetConfig(d.Connection))
+	}
+	return client, result, nil
 }
 
 // getWithRetry fetches a Salesforce object by ID. Since simpleforce's
@@ -740,33 +2389,142 @@ func queryWithRetry(ctx context.Context, d *plugin.QueryData, client *simpleforc
 // "session expired"), this function uses a probe query to disambiguate
 // when Get() returns nil.
 func getWithRetry(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, tableName string, id string) (*simpleforce.Client, *simpleforce.SObject, error) {
-	obj := client.SObject(tableName).Get(id)
-	if obj != nil {
-		return client, obj, nil
-	}
+	var obj *simpleforce.SObject
+	client, err := withSessionRetry(ctx, d, client, tableName, func(client *simpleforce.Client) error {
+		obj = client.SObject(tableName).Get(id)
+		if obj != nil {
+			return nil
+		}
 
-	// Get() returned nil — could be "not found" or session expired.
-	// Use a probe query to check if the session is still valid.
-	probe := fmt.Sprintf("SELECT Id FROM %s WHERE Id = '%s' LIMIT 1", tableName, id)
-	_, err := client.Query(probe)
-	if err == nil {
+		// Get() returned nil — could be "not found" or session expired.
+		// Use a probe query to check if the session is still valid.
+		probe := fmt.Sprintf("SELECT Id FROM %s WHERE Id = '%s' LIMIT 1", tableName, escapeSOQLString(id))
+		if _, err := client.Query(queryPath(getAPIVersion(GetConfig(d.Connection)), queryResource, probe)); err != nil && isSessionExpiredError(err) {
+			return err
+		}
 		// Session is valid; object was genuinely not found (or Get() failed for another reason).
-		return client, nil, nil
+		return nil
+	})
+	if err != nil {
+		return client, nil, err
+	}
+	return client, obj, nil
+}
+
+// restGetWithRetry issues an authenticated GET against a REST path relative to
+// the instance URL (e.g. "services/data/v58.0/limits"). Like queryWithRetry, it
+// reconnects and retries if the session has expired.
+func restGetWithRetry(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, path string) (*simpleforce.Client, []byte, error) {
+	return restRequestWithRetry(ctx, d, client, http.MethodGet, path, nil)
+}
+
+// restRequestWithRetry is restGetWithRetry for any method. The JSON body, if
+// any, is sent again when the request is retried.
+func restRequestWithRetry(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, method string, path string, body []byte) (*simpleforce.Client, []byte, error) {
+	var data []byte
+	client, err := withSessionRetry(ctx, d, client, "", func(client *simpleforce.Client) (err error) {
+		if body == nil {
+			data, err = client.ApexREST(method, path, nil)
+		} else {
+			data, err = client.ApexREST(method, path, bytes.NewReader(body))
+		}
+		return err
+	})
+	if err != nil {
+		return client, nil, err
+	}
+	return client, data, nil
+}
+
+// orgLimit is a single entry of the /limits REST resource.
+type orgLimit struct {
+	Max       int64 `json:"Max"`
+	Remaining int64 `json:"Remaining"`
+}
+
+// getOrgLimits returns the org's limits keyed by limit name, e.g. "DailyApiRequests".
+// Ref: https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_limits.htm
+func getOrgLimits(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client) (map[string]orgLimit, error) {
+	path := fmt.Sprintf("services/data/v%s/limits", getAPIVersion(GetConfig(d.Connection)))
+	_, data, err := restGetWithRetry(ctx, d, client, path)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := map[string]orgLimit{}
+	if err := json.Unmarshal(data, &limits); err != nil {
+		return nil, fmt.Errorf("failed to parse limits response: %v", err)
 	}
+	return limits, nil
+}
+
+// streamQueryRecords runs query and passes each record to stream, following
+// nextRecordsUrl until every page of records has been read. Salesforce returns
+// at most 2000 records per page. It stops early when stream returns false,
+// e.g. once the LIMIT of the Steampipe query is reached, or when ctx is
+// cancelled, so no further pages are requested.
+func streamQueryRecords(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, objectName string, query string, stream func(record map[string]interface{}) bool) (*simpleforce.Client, error) {
+	return streamQueryPages(ctx, client, query, func(client *simpleforce.Client, query string) (*simpleforce.Client, *simpleforce.QueryResult, error) {
+		return queryWithRetry(ctx, d, client, objectName, query)
+	}, stream)
+}
 
-	if !isSessionExpiredError(err) {
-		// Session is valid but query failed for another reason; treat original Get() nil as "not found".
-		return client, nil, nil
+// streamQueryPages passes each record of the pages run returns for query, and
+// then for the nextRecordsUrl of each page, to stream. See streamQueryRecords.
+func streamQueryPages(ctx context.Context, client *simpleforce.Client, query string, run func(client *simpleforce.Client, query string) (*simpleforce.Client, *simpleforce.QueryResult, error), stream func(record map[string]interface{}) bool) (*simpleforce.Client, error) {
+	for {
+		var result *simpleforce.QueryResult
+		var err error
+		client, result, err = run(client, query)
+		if err != nil {
+			return client, err
+		}
+
+		page := new([]map[string]interface{})
+		if err := decodeQueryResult(ctx, result.Records, page); err != nil {
+			return client, err
+		}
+		for _, record := range *page {
+			if !stream(record) {
+				return client, nil
+			}
+		}
+
+		// Paging
+		if result.Done {
+			return client, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return client, err
+		}
+		query = result.NextRecordsURL
 	}
+}
 
-	// Session expired — reconnect and retry.
-	plugin.Logger(ctx).Warn("salesforce.getWithRetry", "msg", "session expired during Get, reconnecting", "table", tableName, "id", id)
+// queryAllRecords runs query and returns the records of every page.
+func queryAllRecords(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, objectName string, query string) (*simpleforce.Client, []map[string]interface{}, error) {
+	records := []map[string]interface{}{}
+	client, err := streamQueryRecords(ctx, d, client, objectName, query, func(record map[string]interface{}) bool {
+		records = append(records, record)
+		return true
+	})
+	if err != nil {
+		return client, nil, err
+	}
+	return client, records, nil
+}
 
-	newClient, reconnErr := reconnect(ctx, d)
-	if reconnErr != nil {
-		return client, nil, reconnErr
+// countRecords runs "SELECT COUNT() FROM <object> [WHERE <condition>]" and
+// returns the totalSize reported by Salesforce.
+func countRecords(ctx context.Context, d *plugin.QueryData, client *simpleforce.Client, objectName string, condition string) (int, error) {
+	query := fmt.Sprintf("SELECT COUNT() FROM %s", objectName)
+	if condition != "" {
+		query = fmt.Sprintf("%s WHERE %s", query, condition)
 	}
 
-	obj = newClient.SObject(tableName).Get(id)
-	return newClient, obj, nil
+	_, result, err := queryWithRetry(ctx, d, client, objectName, query)
+	if err != nil {
+		return 0, err
+	}
+	return result.TotalSize, nil
 }